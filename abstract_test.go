@@ -645,3 +645,47 @@ func TestResolveTypeOnUnionYieldsUsefulError(t *testing.T) {
 		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
 	}
 }
+
+func TestSchema_IsPossibleType(t *testing.T) {
+	petType := graphql.NewInterface(graphql.InterfaceConfig{
+		Name:   "Pet",
+		Fields: graphql.Fields{"name": &graphql.Field{Type: graphql.String}},
+	})
+	newPetObject := func(name string) *graphql.Object {
+		return graphql.NewObject(graphql.ObjectConfig{
+			Name:       name,
+			Interfaces: []*graphql.Interface{petType},
+			IsTypeOf: func(p graphql.IsTypeOfParams) bool {
+				return false
+			},
+			Fields: graphql.Fields{"name": &graphql.Field{Type: graphql.String}},
+		})
+	}
+	dogType, catType, birdType := newPetObject("Dog"), newPetObject("Cat"), newPetObject("Bird")
+	petUnion := graphql.NewUnion(graphql.UnionConfig{
+		Name:  "DogOrCat",
+		Types: []*graphql.Object{dogType, catType},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"pet":      &graphql.Field{Type: petType},
+				"dogOrCat": &graphql.Field{Type: petUnion},
+			},
+		}),
+		Types: []graphql.Type{dogType, catType},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assert.True(t, schema.IsPossibleType(petType, dogType))
+	assert.True(t, schema.IsPossibleType(petUnion, catType))
+	assert.False(t, schema.IsPossibleType(petType, birdType))
+	assert.False(t, schema.IsPossibleType(petUnion, birdType))
+
+	assert.NoError(t, schema.AppendType(birdType))
+	assert.True(t, schema.IsPossibleType(petType, birdType))
+	assert.False(t, schema.IsPossibleType(petUnion, birdType))
+}
@@ -0,0 +1,217 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var appliedDirectivesTestRole = graphql.NewEnum(graphql.EnumConfig{
+	Name: "Role",
+	Values: graphql.EnumValueConfigMap{
+		"ADMIN": &graphql.EnumValueConfig{Value: "ADMIN"},
+		"USER": &graphql.EnumValueConfig{
+			Value:             "USER",
+			AppliedDirectives: []*graphql.AppliedDirective{{Name: "tag", Args: map[string]any{"name": "default"}}},
+		},
+	},
+})
+
+var appliedDirectivesTestAuth = graphql.NewDirective(graphql.DirectiveConfig{
+	Name:      "auth",
+	Locations: []string{graphql.DirectiveLocationObject, graphql.DirectiveLocationFieldDefinition},
+	Args: graphql.FieldConfigArgument{
+		&graphql.ArgumentConfig{Name: "role", Type: graphql.NewNonNull(appliedDirectivesTestRole)},
+	},
+})
+
+var appliedDirectivesTestTag = graphql.NewDirective(graphql.DirectiveConfig{
+	Name: "tag",
+	Locations: []string{
+		graphql.DirectiveLocationArgumentDefinition,
+		graphql.DirectiveLocationEnumValue,
+		graphql.DirectiveLocationInputFieldDefinition,
+	},
+	Args: graphql.FieldConfigArgument{
+		&graphql.ArgumentConfig{Name: "name", Type: graphql.NewNonNull(graphql.String)},
+	},
+	IsRepeatable: true,
+})
+
+var appliedDirectivesTestDirectives = append(
+	append([]*graphql.Directive{}, graphql.SpecifiedDirectives...),
+	appliedDirectivesTestAuth, appliedDirectivesTestTag,
+)
+
+func appliedDirectivesTestQuery(fieldDirectives []*graphql.AppliedDirective) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name:              "Query",
+		AppliedDirectives: []*graphql.AppliedDirective{{Name: "auth", Args: map[string]any{"role": "USER"}}},
+		Fields: graphql.Fields{
+			"secret": &graphql.Field{
+				Type:              graphql.String,
+				AppliedDirectives: fieldDirectives,
+				Args: graphql.FieldConfigArgument{
+					&graphql.ArgumentConfig{
+						Name: "role",
+						Type: appliedDirectivesTestRole,
+						AppliedDirectives: []*graphql.AppliedDirective{
+							{Name: "tag", Args: map[string]any{"name": "a"}},
+							{Name: "tag", Args: map[string]any{"name": "b"}},
+						},
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					roles := []any{}
+					for _, directive := range p.Info.ParentType.(*graphql.Object).AppliedDirectives() {
+						roles = append(roles, directive.Args["role"])
+					}
+					for _, directive := range p.Info.FieldDefinition.AppliedDirectives {
+						roles = append(roles, directive.Args["role"])
+					}
+					return roles, nil
+				},
+			},
+		},
+	})
+}
+
+func TestAppliedDirectives_AreReachableThroughResolveInfo(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: appliedDirectivesTestQuery([]*graphql.AppliedDirective{
+			{Name: "auth", Args: map[string]any{"role": "ADMIN"}},
+		}),
+		Directives: appliedDirectivesTestDirectives,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ secret }`,
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{"secret": "[USER ADMIN]"}, result.Data)
+}
+
+func TestAppliedDirectives_ArePrintedInSDL(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: appliedDirectivesTestQuery([]*graphql.AppliedDirective{
+			{Name: "auth", Args: map[string]any{"role": "ADMIN"}},
+		}),
+		Directives: appliedDirectivesTestDirectives,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `directive @auth(role: Role!) on OBJECT | FIELD_DEFINITION
+
+directive @tag(name: String!) repeatable on ARGUMENT_DEFINITION | ENUM_VALUE | INPUT_FIELD_DEFINITION
+
+type Query @auth(role: USER) {
+  secret(role: Role @tag(name: "a") @tag(name: "b")): String @auth(role: ADMIN)
+}
+
+enum Role {
+  ADMIN
+  USER @tag(name: "default")
+}`
+	if printed := graphql.PrintSchema(schema); printed != expected {
+		t.Fatalf("Unexpected SDL, Diff: %v", testutil.Diff(expected, printed))
+	}
+}
+
+func TestAppliedDirectives_AreValidatedByNewSchema(t *testing.T) {
+	tests := []struct {
+		name       string
+		directives []*graphql.AppliedDirective
+		message    string
+	}{
+		{
+			name:       "unknown directive",
+			directives: []*graphql.AppliedDirective{{Name: "cost"}},
+			message:    `Unknown directive "@cost" applied to Query.secret.`,
+		},
+		{
+			name:       "wrong location",
+			directives: []*graphql.AppliedDirective{{Name: "tag", Args: map[string]any{"name": "a"}}},
+			message:    `Directive "@tag" may not be applied to Query.secret at location FIELD_DEFINITION.`,
+		},
+		{
+			name: "repeated",
+			directives: []*graphql.AppliedDirective{
+				{Name: "auth", Args: map[string]any{"role": "ADMIN"}},
+				{Name: "auth", Args: map[string]any{"role": "USER"}},
+			},
+			message: `Directive "@auth" can only be applied once to Query.secret.`,
+		},
+		{
+			name:       "unknown argument",
+			directives: []*graphql.AppliedDirective{{Name: "auth", Args: map[string]any{"role": "ADMIN", "scope": "all"}}},
+			message:    `Directive "@auth" applied to Query.secret has unknown argument "scope".`,
+		},
+		{
+			name:       "missing argument",
+			directives: []*graphql.AppliedDirective{{Name: "auth"}},
+			message:    `Directive "@auth" applied to Query.secret requires argument "role" of type Role!.`,
+		},
+		{
+			name:       "invalid value",
+			directives: []*graphql.AppliedDirective{{Name: "auth", Args: map[string]any{"role": nil}}},
+			message:    `Directive "@auth" applied to Query.secret has invalid value for argument "role": Expected "Role!", found null.`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := graphql.NewSchema(graphql.SchemaConfig{
+				Query:      appliedDirectivesTestQuery(test.directives),
+				Directives: appliedDirectivesTestDirectives,
+			})
+			assert.EqualError(t, err, test.message)
+		})
+	}
+}
+
+func TestAppliedDirectives_AreBuiltAndExtendedFromSDL(t *testing.T) {
+	sdl := `directive @cost(weight: Int!) on OBJECT | FIELD_DEFINITION | SCALAR
+
+directive @tag(name: String!) repeatable on OBJECT
+
+type Query @cost(weight: 1) {
+  hello: String @cost(weight: 5)
+}`
+	schema, err := graphql.BuildSchema(sdl, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if printed := graphql.PrintSchema(schema); printed != sdl {
+		t.Fatalf("Unexpected SDL, Diff: %v", testutil.Diff(sdl, printed))
+	}
+	field := schema.QueryType().Fields()["hello"]
+	assert.Equal(t, []*graphql.AppliedDirective{{Name: "cost", Args: map[string]any{"weight": int64(5)}}}, field.AppliedDirectives)
+
+	extended, err := graphql.ExtendSchema(schema, testutil.TestParse(t, `
+		scalar Date @cost(weight: 3)
+		extend type Query @tag(name: "a") @tag(name: "b")
+	`), graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `directive @cost(weight: Int!) on OBJECT | FIELD_DEFINITION | SCALAR
+
+directive @tag(name: String!) repeatable on OBJECT
+
+scalar Date @cost(weight: 3)
+
+type Query @cost(weight: 1) @tag(name: "a") @tag(name: "b") {
+  hello: String @cost(weight: 5)
+}`
+	if printed := graphql.PrintSchema(extended); printed != expected {
+		t.Fatalf("Unexpected SDL, Diff: %v", testutil.Diff(expected, printed))
+	}
+
+	_, err = graphql.ExtendSchema(schema, testutil.TestParse(t, `extend type Query @cost(weight: 2)`), graphql.BuildSchemaOptions{})
+	assert.EqualError(t, err, `Directive "@cost" can only be applied once to Query.`)
+}
@@ -0,0 +1,66 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+)
+
+// Batcher is implemented by loaders which collect the keys requested by
+// resolvers to load them at once, like the loaders of the dataloader package.
+type Batcher interface {
+	// Flush loads the keys collected so far.
+	Flush()
+}
+
+type batchersKey struct{}
+
+// batchers are the Batchers scheduled for an execution.
+type batchers struct {
+	mu        sync.Mutex
+	batchers  []Batcher
+	scheduled map[Batcher]bool
+}
+
+func (b *batchers) add(batcher Batcher) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.scheduled[batcher] {
+		return
+	}
+	b.scheduled[batcher] = true
+	b.batchers = append(b.batchers, batcher)
+}
+
+// flush flushes the Batchers scheduled since the last flush, in the order
+// they were scheduled. They are flushed again once they are scheduled again.
+func (b *batchers) flush() {
+	b.mu.Lock()
+	batchers := b.batchers
+	b.batchers = nil
+	b.scheduled = map[Batcher]bool{}
+	b.mu.Unlock()
+	for _, batcher := range batchers {
+		batcher.Flush()
+	}
+}
+
+// ScheduleFlush schedules the Batcher for the execution ctx belongs to: the
+// executor flushes it once, before it calls the thunks of the fields of the
+// next breadth-first level of the result. Resolvers of a level thus collect
+// their keys in one batch, which the thunks they return wait for. Batchers
+// are scheduled again for every batch they collect.
+//
+// ScheduleFlush returns false if ctx doesn't belong to the execution of a
+// query or subscription. The thunks of mutations are called depth-first, one
+// at a time, so batches are flushed by their thunks instead.
+func ScheduleFlush(ctx context.Context, batcher Batcher) bool {
+	if ctx == nil {
+		return false
+	}
+	b, ok := ctx.Value(batchersKey{}).(*batchers)
+	if !ok {
+		return false
+	}
+	b.add(batcher)
+	return true
+}
@@ -0,0 +1,99 @@
+package graphql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/stretchr/testify/assert"
+)
+
+// flushCounter counts how often the executor flushed it.
+type flushCounter struct {
+	flushes int
+}
+
+func (c *flushCounter) Flush() {
+	c.flushes++
+}
+
+func TestScheduleFlush_FlushesOncePerLevel(t *testing.T) {
+	counter := &flushCounter{}
+	// once is only scheduled by the root field
+	once := &flushCounter{}
+	scheduled := []bool{}
+	// resolveFlushes returns a thunk resolving to the number of flushes
+	// before the thunk was called
+	resolveFlushes := func(p graphql.ResolveParams) (any, error) {
+		if p.Info.Path.Prev == nil {
+			graphql.ScheduleFlush(p.Context, once)
+		}
+		scheduled = append(scheduled, graphql.ScheduleFlush(p.Context, counter))
+		return func() (int, error) {
+			return counter.flushes, nil
+		}, nil
+	}
+	var nodeType *graphql.Object
+	nodeType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Node",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"flushes": &graphql.Field{Type: graphql.Int, Resolve: resolveFlushes},
+				"node": &graphql.Field{
+					Type: nodeType,
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return func() (map[string]any, error) {
+							return map[string]any{}, nil
+						}, nil
+					},
+				},
+			}
+		}),
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"flushes": &graphql.Field{Type: graphql.Int, Resolve: resolveFlushes},
+				"node":    &graphql.Field{Type: nodeType, Resolve: func(p graphql.ResolveParams) (any, error) { return map[string]any{}, nil }},
+			},
+		}),
+		Mutation: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Mutation",
+			Fields: graphql.Fields{
+				"flushes": &graphql.Field{Type: graphql.Int, Resolve: resolveFlushes},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ flushes node { flushes node { flushes node { flushes } } } }`,
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{
+		"flushes": int64(1),
+		"node": map[string]any{
+			// resolved on the same level as the root field
+			"flushes": int64(1),
+			"node": map[string]any{
+				"flushes": int64(2),
+				"node":    map[string]any{"flushes": int64(3)},
+			},
+		},
+	}, result.Data)
+	assert.Equal(t, []bool{true, true, true, true}, scheduled)
+	assert.Equal(t, 1, once.flushes)
+
+	counter.flushes = 0
+	scheduled = nil
+	result = graphql.Do(graphql.Params{Schema: schema, RequestString: `mutation { flushes }`})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{"flushes": int64(0)}, result.Data)
+	assert.Equal(t, []bool{false}, scheduled)
+
+	assert.False(t, graphql.ScheduleFlush(context.Background(), counter))
+}
@@ -0,0 +1,503 @@
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/fraym/graphql-go/gqlerrors"
+	"github.com/fraym/graphql-go/language/parser"
+)
+
+// BuildClientSchema builds a Schema from the result of an introspection query,
+// e.g. testutil.IntrospectionQuery, as returned by a remote service.
+//
+// The introspection may be the data of the result, containing the "__schema" key,
+// or the whole result, containing the "data" key.
+//
+// The schema can be used to validate documents and to introspect the remote
+// service, but it cannot be used to execute queries: all resolvers return an error.
+func BuildClientSchema(introspection map[string]any) (Schema, error) {
+	if data, ok := introspection["data"].(map[string]any); ok {
+		introspection = data
+	}
+	schemaIntrospection, ok := introspection["__schema"].(map[string]any)
+	if !ok {
+		return Schema{}, gqlerrors.NewFormattedError(
+			"Invalid or incomplete introspection result. Ensure that you are passing the " +
+				`"data" property of the introspection query result.`,
+		)
+	}
+
+	b := &clientSchemaBuilder{
+		typeDefs: map[string]map[string]any{},
+		types:    map[string]Type{},
+	}
+	typeNames := []string{}
+	typeDefs, _ := schemaIntrospection["types"].([]any)
+	for _, typeDef := range typeDefs {
+		typeDef, ok := typeDef.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := typeDef["name"].(string)
+		if name == "" {
+			return Schema{}, gqlerrors.NewFormattedError(fmt.Sprintf("Invalid or incomplete introspection result, type without name: %v.", typeDef))
+		}
+		b.typeDefs[name] = typeDef
+		typeNames = append(typeNames, name)
+	}
+
+	queryType, err := b.rootType(schemaIntrospection["queryType"])
+	if err != nil {
+		return Schema{}, err
+	}
+	if queryType == nil {
+		return Schema{}, gqlerrors.NewFormattedError("Invalid or incomplete introspection result, missing query type.")
+	}
+	mutationType, err := b.rootType(schemaIntrospection["mutationType"])
+	if err != nil {
+		return Schema{}, err
+	}
+	subscriptionType, err := b.rootType(schemaIntrospection["subscriptionType"])
+	if err != nil {
+		return Schema{}, err
+	}
+
+	types := []Type{}
+	for _, name := range typeNames {
+		ttype, err := b.namedType(name)
+		if err != nil {
+			return Schema{}, err
+		}
+		if isIntrospectionType(ttype) || isSpecifiedScalarType(ttype) {
+			continue
+		}
+		types = append(types, ttype)
+	}
+
+	directives := []*Directive{}
+	directiveDefs, ok := schemaIntrospection["directives"].([]any)
+	if !ok {
+		// introspection results without directives use the specified ones
+		directives = SpecifiedDirectives
+	}
+	for _, directiveDef := range directiveDefs {
+		directiveDef, ok := directiveDef.(map[string]any)
+		if !ok {
+			continue
+		}
+		directive, err := b.buildDirective(directiveDef)
+		if err != nil {
+			return Schema{}, err
+		}
+		directives = append(directives, directive)
+	}
+
+	schema, err := NewSchema(SchemaConfig{
+		Query:        queryType,
+		Mutation:     mutationType,
+		Subscription: subscriptionType,
+		Types:        types,
+		Directives:   directives,
+	})
+	if err != nil {
+		return schema, err
+	}
+	if len(b.errs) > 0 {
+		return schema, b.errs[0]
+	}
+	return schema, nil
+}
+
+type clientSchemaBuilder struct {
+	typeDefs map[string]map[string]any
+	types    map[string]Type
+
+	// errors found while lazily building fields
+	errs []error
+}
+
+// errClientSchemaExecution is returned by every resolver of a client schema.
+func errClientSchemaExecution(typeName, fieldName string) error {
+	return fmt.Errorf(`cannot execute "%v.%v": client schemas built from introspection cannot be executed`, typeName, fieldName)
+}
+
+func (b *clientSchemaBuilder) rootType(typeRef any) (*Object, error) {
+	ref, ok := typeRef.(map[string]any)
+	if !ok {
+		return nil, nil
+	}
+	name, _ := ref["name"].(string)
+	ttype, err := b.namedType(name)
+	if err != nil {
+		return nil, err
+	}
+	object, ok := ttype.(*Object)
+	if !ok {
+		return nil, gqlerrors.NewFormattedError(fmt.Sprintf("Root type %v must be an object type.", name))
+	}
+	return object, nil
+}
+
+func (b *clientSchemaBuilder) namedType(name string) (Type, error) {
+	if ttype, ok := specifiedScalarTypes[name]; ok {
+		return ttype, nil
+	}
+	if ttype, ok := introspectionTypes()[name]; ok {
+		return ttype, nil
+	}
+	if ttype, ok := b.types[name]; ok {
+		return ttype, nil
+	}
+	typeDef, ok := b.typeDefs[name]
+	if !ok {
+		return nil, gqlerrors.NewFormattedError(fmt.Sprintf(
+			"Invalid or incomplete schema, unknown type: %v. Ensure that a full introspection "+
+				"query is used in order to build a client schema.", name,
+		))
+	}
+
+	var ttype Type
+	kind, _ := typeDef["kind"].(string)
+	switch kind {
+	case TypeKindScalar:
+		ttype = b.buildScalar(typeDef)
+	case TypeKindObject:
+		ttype = b.buildObject(typeDef)
+	case TypeKindInterface:
+		ttype = b.buildInterface(typeDef)
+	case TypeKindUnion:
+		ttype = b.buildUnion(typeDef)
+	case TypeKindEnum:
+		ttype = b.buildEnum(typeDef)
+	case TypeKindInputObject:
+		ttype = b.buildInputObject(typeDef)
+	default:
+		return nil, gqlerrors.NewFormattedError(fmt.Sprintf(
+			"Invalid or incomplete introspection result, unknown kind %q of type %v.", kind, name,
+		))
+	}
+	if err := ttype.Error(); err != nil {
+		return nil, err
+	}
+	b.types[name] = ttype
+	return ttype, nil
+}
+
+// typeRef builds the type referenced by the given introspection type, wrapping it in lists and non-nulls.
+func (b *clientSchemaBuilder) typeRef(typeRef any) (Type, error) {
+	ref, ok := typeRef.(map[string]any)
+	if !ok {
+		return nil, gqlerrors.NewFormattedError("Invalid or incomplete introspection result, missing type reference.")
+	}
+	kind, _ := ref["kind"].(string)
+	switch kind {
+	case TypeKindList:
+		ofType, err := b.typeRef(ref["ofType"])
+		if err != nil {
+			return nil, err
+		}
+		return NewList(ofType), nil
+	case TypeKindNonNull:
+		ofType, err := b.typeRef(ref["ofType"])
+		if err != nil {
+			return nil, err
+		}
+		return NewNonNull(ofType), nil
+	}
+	name, _ := ref["name"].(string)
+	return b.namedType(name)
+}
+
+func (b *clientSchemaBuilder) buildScalar(typeDef map[string]any) *Scalar {
+	return NewScalar(ScalarConfig{
+		Name:           stringValue(typeDef, "name"),
+		Description:    stringValue(typeDef, "description"),
+		SpecifiedByURL: stringValue(typeDef, "specifiedByURL"),
+		Serialize:      identityValue,
+		ParseValue:     identityValue,
+		ParseLiteral:   valueFromUntypedAST,
+	})
+}
+
+func (b *clientSchemaBuilder) buildObject(typeDef map[string]any) *Object {
+	name := stringValue(typeDef, "name")
+	return NewObject(ObjectConfig{
+		Name:        name,
+		Description: stringValue(typeDef, "description"),
+		Interfaces: InterfacesThunk(func() []*Interface {
+			return b.buildInterfaces(name, typeDef)
+		}),
+		Fields: FieldsThunk(func() Fields {
+			return b.buildFields(name, typeDef)
+		}),
+	})
+}
+
+func (b *clientSchemaBuilder) buildInterfaces(typeName string, typeDef map[string]any) []*Interface {
+	ifaces := []*Interface{}
+	for _, ref := range sliceValue(typeDef, "interfaces") {
+		ttype, err := b.typeRef(ref)
+		if err != nil {
+			b.errs = append(b.errs, err)
+			continue
+		}
+		iface, ok := ttype.(*Interface)
+		if !ok {
+			b.errs = append(b.errs, gqlerrors.NewFormattedError(fmt.Sprintf("%v may only implement Interface types, it cannot implement: %v.", typeName, ttype)))
+			continue
+		}
+		ifaces = append(ifaces, iface)
+	}
+	return ifaces
+}
+
+func (b *clientSchemaBuilder) buildInterface(typeDef map[string]any) *Interface {
+	name := stringValue(typeDef, "name")
+	return NewInterface(InterfaceConfig{
+		Name:        name,
+		Description: stringValue(typeDef, "description"),
+		ResolveType: resolveTypeByTypename,
+		Interfaces: InterfacesThunk(func() []*Interface {
+			return b.buildInterfaces(name, typeDef)
+		}),
+		Fields: FieldsThunk(func() Fields {
+			return b.buildFields(name, typeDef)
+		}),
+	})
+}
+
+func (b *clientSchemaBuilder) buildFields(typeName string, typeDef map[string]any) Fields {
+	fields := Fields{}
+	fieldDefs, ok := typeDef["fields"].([]any)
+	if !ok {
+		b.errs = append(b.errs, gqlerrors.NewFormattedError(fmt.Sprintf("Introspection result missing fields: %v.", typeName)))
+		return fields
+	}
+	for _, fieldDef := range fieldDefs {
+		fieldDef, ok := fieldDef.(map[string]any)
+		if !ok {
+			continue
+		}
+		fieldName := stringValue(fieldDef, "name")
+		ttype, err := b.typeRef(fieldDef["type"])
+		if err != nil {
+			b.errs = append(b.errs, err)
+			continue
+		}
+		args, err := b.buildArgs(fieldDef)
+		if err != nil {
+			b.errs = append(b.errs, err)
+			continue
+		}
+		resolveFn := func(p ResolveParams) (any, error) {
+			return nil, errClientSchemaExecution(typeName, fieldName)
+		}
+		fields[fieldName] = &Field{
+			Name:              fieldName,
+			Description:       stringValue(fieldDef, "description"),
+			Type:              ttype,
+			Args:              args,
+			Resolve:           resolveFn,
+			Subscribe:         resolveFn,
+			DeprecationReason: deprecationReasonValue(fieldDef),
+		}
+	}
+	return fields
+}
+
+func (b *clientSchemaBuilder) buildArgs(def map[string]any) (FieldConfigArgument, error) {
+	args := FieldConfigArgument{}
+	for _, argDef := range sliceValue(def, "args") {
+		argDef, ok := argDef.(map[string]any)
+		if !ok {
+			continue
+		}
+		ttype, defaultValue, err := b.buildInputValue(argDef)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, &ArgumentConfig{
+			Name:              stringValue(argDef, "name"),
+			Description:       stringValue(argDef, "description"),
+			Type:              ttype,
+			DefaultValue:      defaultValue,
+			DeprecationReason: stringValue(argDef, "deprecationReason"),
+		})
+	}
+	return args, nil
+}
+
+// buildInputValue returns the type and the parsed default value of an argument or input field.
+func (b *clientSchemaBuilder) buildInputValue(def map[string]any) (Input, any, error) {
+	ttype, err := b.typeRef(def["type"])
+	if err != nil {
+		return nil, nil, err
+	}
+	defaultValueStr, ok := def["defaultValue"].(string)
+	if !ok {
+		return ttype, nil, nil
+	}
+	valueAST, err := parser.ParseValue(parser.ParseParams{Source: defaultValueStr})
+	if err != nil {
+		return nil, nil, err
+	}
+	defaultValue, err := valueFromAST(valueAST, ttype, nil)
+	if err != nil {
+		return nil, nil, gqlerrors.NewFormattedError(fmt.Sprintf(
+			`Invalid default value %v for "%v": %v`, defaultValueStr, stringValue(def, "name"), err,
+		))
+	}
+	return ttype, defaultValue, nil
+}
+
+func (b *clientSchemaBuilder) buildUnion(typeDef map[string]any) *Union {
+	name := stringValue(typeDef, "name")
+	return NewUnion(UnionConfig{
+		Name:        name,
+		Description: stringValue(typeDef, "description"),
+		ResolveType: resolveTypeByTypename,
+		Types: UnionTypesThunk(func() []*Object {
+			types := []*Object{}
+			for _, ref := range sliceValue(typeDef, "possibleTypes") {
+				ttype, err := b.typeRef(ref)
+				if err != nil {
+					b.errs = append(b.errs, err)
+					continue
+				}
+				object, ok := ttype.(*Object)
+				if !ok {
+					b.errs = append(b.errs, gqlerrors.NewFormattedError(fmt.Sprintf("%v may only contain Object types, it cannot contain: %v.", name, ttype)))
+					continue
+				}
+				types = append(types, object)
+			}
+			return types
+		}),
+	})
+}
+
+func (b *clientSchemaBuilder) buildEnum(typeDef map[string]any) *Enum {
+	values := EnumValueConfigMap{}
+	for _, valueDef := range sliceValue(typeDef, "enumValues") {
+		valueDef, ok := valueDef.(map[string]any)
+		if !ok {
+			continue
+		}
+		valueName := stringValue(valueDef, "name")
+		values[valueName] = &EnumValueConfig{
+			Value:             valueName,
+			Description:       stringValue(valueDef, "description"),
+			DeprecationReason: deprecationReasonValue(valueDef),
+		}
+	}
+	return NewEnum(EnumConfig{
+		Name:        stringValue(typeDef, "name"),
+		Description: stringValue(typeDef, "description"),
+		Values:      values,
+	})
+}
+
+func (b *clientSchemaBuilder) buildInputObject(typeDef map[string]any) *InputObject {
+	return NewInputObject(InputObjectConfig{
+		Name:        stringValue(typeDef, "name"),
+		Description: stringValue(typeDef, "description"),
+		IsOneOf:     boolValue(typeDef, "isOneOf"),
+		Fields: InputObjectConfigFieldMapThunk(func() InputObjectConfigFieldMap {
+			fields := InputObjectConfigFieldMap{}
+			for _, fieldDef := range sliceValue(typeDef, "inputFields") {
+				fieldDef, ok := fieldDef.(map[string]any)
+				if !ok {
+					continue
+				}
+				ttype, defaultValue, err := b.buildInputValue(fieldDef)
+				if err != nil {
+					b.errs = append(b.errs, err)
+					continue
+				}
+				fields[stringValue(fieldDef, "name")] = &InputObjectFieldConfig{
+					Type:              ttype,
+					Description:       stringValue(fieldDef, "description"),
+					DefaultValue:      defaultValue,
+					DeprecationReason: stringValue(fieldDef, "deprecationReason"),
+				}
+			}
+			return fields
+		}),
+	})
+}
+
+func (b *clientSchemaBuilder) buildDirective(directiveDef map[string]any) (*Directive, error) {
+	locations := []string{}
+	if locationDefs, ok := directiveDef["locations"].([]any); ok {
+		for _, location := range locationDefs {
+			if location, ok := location.(string); ok {
+				locations = append(locations, location)
+			}
+		}
+	} else {
+		// older introspection results only describe where directives may be used
+		if onOperation, _ := directiveDef["onOperation"].(bool); onOperation {
+			locations = append(locations, DirectiveLocationQuery, DirectiveLocationMutation, DirectiveLocationSubscription)
+		}
+		if onFragment, _ := directiveDef["onFragment"].(bool); onFragment {
+			locations = append(locations, DirectiveLocationFragmentSpread, DirectiveLocationInlineFragment, DirectiveLocationFragmentDefinition)
+		}
+		if onField, _ := directiveDef["onField"].(bool); onField {
+			locations = append(locations, DirectiveLocationField)
+		}
+	}
+	args, err := b.buildArgs(directiveDef)
+	if err != nil {
+		return nil, err
+	}
+	directive := NewDirective(DirectiveConfig{
+		Name:         stringValue(directiveDef, "name"),
+		Description:  stringValue(directiveDef, "description"),
+		Locations:    locations,
+		Args:         args,
+		IsRepeatable: boolValue(directiveDef, "isRepeatable"),
+	})
+	if directive.err != nil {
+		return nil, directive.err
+	}
+	return directive, nil
+}
+
+// introspectionTypes are part of every schema and are never rebuilt from an introspection result.
+func introspectionTypes() map[string]Type {
+	return map[string]Type{
+		SchemaType.Name():                SchemaType,
+		TypeType.Name():                  TypeType,
+		FieldType.Name():                 FieldType,
+		InputValueType.Name():            InputValueType,
+		EnumValueType.Name():             EnumValueType,
+		DirectiveType.Name():             DirectiveType,
+		TypeKindEnumType.Name():          TypeKindEnumType,
+		DirectiveLocationEnumType.Name(): DirectiveLocationEnumType,
+	}
+}
+
+func stringValue(def map[string]any, key string) string {
+	value, _ := def[key].(string)
+	return value
+}
+
+func boolValue(def map[string]any, key string) bool {
+	value, _ := def[key].(bool)
+	return value
+}
+
+func sliceValue(def map[string]any, key string) []any {
+	value, _ := def[key].([]any)
+	return value
+}
+
+func deprecationReasonValue(def map[string]any) string {
+	if isDeprecated, _ := def["isDeprecated"].(bool); !isDeprecated {
+		return ""
+	}
+	if reason := stringValue(def, "deprecationReason"); reason != "" {
+		return reason
+	}
+	return DefaultDeprecationReason
+}
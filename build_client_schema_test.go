@@ -0,0 +1,217 @@
+package graphql_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+const clientSchemaTestSDL = `schema {
+  query: Root
+  mutation: Mutation
+}
+
+"""Marks a field as expensive"""
+directive @cost(weight: Int = 1) on FIELD
+
+type Dog implements Pet {
+  barks: Boolean
+  name: String
+}
+
+enum Mood {
+  GRUMPY @deprecated(reason: "Never grumpy")
+  HAPPY
+}
+
+type Mutation {
+  adopt(input: PetInput!): Pet
+}
+
+"""
+Something with
+a name
+"""
+interface Pet {
+  name: String
+}
+
+input PetInput {
+  mood: Mood = HAPPY
+  name: String!
+  tags: [String!] = ["new"]
+  weight: Float = 1.5
+}
+
+type Root {
+  pet(id: ID!, mood: Mood = HAPPY): Pet
+  search(first: Int = 10, term: Timestamp): [SearchResult!]!
+  oldField: String @deprecated
+}
+
+union SearchResult = Dog
+
+"""A point in time"""
+scalar Timestamp @specifiedBy(url: "https://tools.ietf.org/html/rfc3339")`
+
+func introspect(t *testing.T, schema graphql.Schema) map[string]any {
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: testutil.IntrospectionQuery,
+	})
+	if len(result.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+	return result.Data.(map[string]any)
+}
+
+func TestBuildClientSchema_ReconstructsSchemaFromIntrospection(t *testing.T) {
+	serverSchema, err := graphql.BuildSchema(clientSchemaTestSDL, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	clientSchema, err := graphql.BuildClientSchema(introspect(t, serverSchema))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := graphql.PrintSchema(serverSchema)
+	if printed := graphql.PrintSchema(clientSchema); printed != expected {
+		t.Fatalf("Unexpected SDL, Diff: %v", testutil.Diff(expected, printed))
+	}
+	if !testutil.ContainSubset(introspect(t, serverSchema), introspect(t, clientSchema)) {
+		t.Fatalf("Unexpected introspection, Diff: %v", testutil.Diff(introspect(t, serverSchema), introspect(t, clientSchema)))
+	}
+}
+
+func TestBuildClientSchema_AcceptsDecodedJSONResult(t *testing.T) {
+	serverSchema, err := graphql.BuildSchema(clientSchemaTestSDL, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:        serverSchema,
+		RequestString: testutil.IntrospectionQuery,
+	})
+	body, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	introspection := map[string]any{}
+	if err := json.Unmarshal(body, &introspection); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	clientSchema, err := graphql.BuildClientSchema(introspection)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, graphql.PrintSchema(serverSchema), graphql.PrintSchema(clientSchema))
+}
+
+func TestBuildClientSchema_ValidatesDocuments(t *testing.T) {
+	serverSchema, err := graphql.BuildSchema(clientSchemaTestSDL, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clientSchema, err := graphql.BuildClientSchema(introspect(t, serverSchema))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	valid := testutil.TestParse(t, `
+		query Search($term: Timestamp) {
+			pet(id: 1, mood: HAPPY) { name ... on Dog { barks } }
+			search(term: $term) @cost(weight: 2) { ... on Dog { name } }
+		}
+		mutation Adopt { adopt(input: {name: "Rex", tags: ["a"]}) { name } }
+	`)
+	result := graphql.ValidateDocument(&clientSchema, valid, nil)
+	assert.True(t, result.IsValid, "%v", result.Errors)
+
+	invalid := testutil.TestParse(t, `{ pet(id: 1, mood: SAD) { age } }`)
+	result = graphql.ValidateDocument(&clientSchema, invalid, nil)
+	assert.False(t, result.IsValid)
+	messages := []string{}
+	for _, err := range result.Errors {
+		messages = append(messages, err.Message)
+	}
+	assert.Contains(t, messages, `Cannot query field "age" on type "Pet". Did you mean "name"?`)
+	assert.Len(t, messages, 2)
+}
+
+func TestBuildClientSchema_ResolversFailLoudly(t *testing.T) {
+	serverSchema, err := graphql.BuildSchema(clientSchemaTestSDL, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clientSchema, err := graphql.BuildClientSchema(introspect(t, serverSchema))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        clientSchema,
+		RequestString: `{ oldField }`,
+	})
+	assert.Len(t, result.Errors, 1)
+	assert.Equal(t, `cannot execute "Root.oldField": client schemas built from introspection cannot be executed`, result.Errors[0].Message)
+}
+
+func TestBuildClientSchema_RejectsIncompleteIntrospection(t *testing.T) {
+	_, err := graphql.BuildClientSchema(map[string]any{})
+	assert.EqualError(t, err, `Invalid or incomplete introspection result. Ensure that you are passing the "data" property of the introspection query result.`)
+
+	_, err = graphql.BuildClientSchema(map[string]any{
+		"__schema": map[string]any{
+			"queryType": map[string]any{"name": "Query"},
+			"types":     []any{},
+		},
+	})
+	assert.EqualError(t, err, "Invalid or incomplete schema, unknown type: Query. Ensure that a full introspection query is used in order to build a client schema.")
+}
+
+func TestBuildClientSchema_ReconstructsInterfacesImplementingInterfaces(t *testing.T) {
+	serverSchema, err := graphql.BuildSchema(interfaceInheritanceSDL, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clientSchema, err := graphql.BuildClientSchema(introspect(t, serverSchema))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	image := clientSchema.Type("Image").(*graphql.Interface)
+	names := []string{}
+	for _, iface := range image.Interfaces() {
+		names = append(names, iface.Name())
+	}
+	assert.Equal(t, []string{"Resource", "Node"}, names)
+	assert.Equal(t, graphql.PrintSchema(serverSchema), graphql.PrintSchema(clientSchema))
+}
+
+func TestBuildClientSchema_ReconstructsRepeatableDirectives(t *testing.T) {
+	serverSchema, err := graphql.BuildSchema(`
+directive @tag(name: String!) repeatable on FIELD
+directive @once on FIELD
+
+type Query {
+  hello: String
+}`, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	clientSchema, err := graphql.BuildClientSchema(introspect(t, serverSchema))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assert.True(t, clientSchema.Directive("tag").IsRepeatable)
+	assert.False(t, clientSchema.Directive("once").IsRepeatable)
+	assert.False(t, clientSchema.Directive("include").IsRepeatable)
+	assert.Equal(t, graphql.PrintSchema(serverSchema), graphql.PrintSchema(clientSchema))
+}
@@ -0,0 +1,1123 @@
+package graphql
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/fraym/graphql-go/gqlerrors"
+	"github.com/fraym/graphql-go/language/ast"
+	"github.com/fraym/graphql-go/language/parser"
+	"github.com/fraym/graphql-go/language/source"
+)
+
+// BuildSchemaOptions wires runtime behaviour into a schema built from SDL.
+//
+// Coordinates use the "Type.field" form for fields and the "Enum.VALUE" form
+// for enum values.
+type BuildSchemaOptions struct {
+	// Resolvers maps field coordinates to the resolve function of that field.
+	// Fields without a resolver use DefaultResolveFn.
+	Resolvers map[string]FieldResolveFn
+
+	// Subscribers maps field coordinates to the subscribe function of that field.
+	Subscribers map[string]FieldResolveFn
+
+	// ResolveType maps interface and union names to their ResolveTypeFn.
+	// Abstract types without an entry resolve map values by their "__typename"
+	// key and fall back to the IsTypeOf functions of their possible types.
+	ResolveType map[string]ResolveTypeFn
+
+	// IsTypeOf maps object type names to their IsTypeOfFn.
+	IsTypeOf map[string]IsTypeOfFn
+
+	// Scalars maps custom scalar names to the functions used to serialize and
+	// parse them. Name and description are taken from the SDL if left empty.
+	// Scalars without an entry pass their values through unchanged.
+	Scalars map[string]ScalarConfig
+
+	// EnumValues maps enum value coordinates to their internal value.
+	// Enum values without an entry use their name as internal value.
+	EnumValues map[string]any
+
+	// Extensions are added to the built schema.
+	Extensions []Extension
+}
+
+// specifiedScalarTypes are available in every SDL document without being declared.
+var specifiedScalarTypes = map[string]*Scalar{
+	Int.Name():     Int,
+	Float.Name():   Float,
+	String.Name():  String,
+	Boolean.Name(): Boolean,
+	ID.Name():      ID,
+}
+
+// knownScalarTypes are used for scalars declared in SDL that have no hook in BuildSchemaOptions.Scalars.
+var knownScalarTypes = map[string]*Scalar{
+	DateTime.Name(): DateTime,
+}
+
+// BuildSchema parses the given SDL and builds a Schema from its type system definitions.
+func BuildSchema(sdl string, options BuildSchemaOptions) (Schema, error) {
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{
+			Body: []byte(sdl),
+			Name: "GraphQL SDL",
+		}),
+	})
+	if err != nil {
+		return Schema{}, err
+	}
+	return BuildASTSchema(doc, options)
+}
+
+// BuildASTSchema builds a Schema from the type system definitions of a parsed document.
+// Type and schema extensions in the document are applied to the definitions they extend.
+//
+// If the document contains no schema definition, the object types named Query,
+// Mutation and Subscription are used as root operation types.
+//
+// Invalid definitions are reported as *gqlerrors.Error. If there are several
+// invalid definitions or options, the returned error joins all of them, see
+// errors.Join. Types are only built once the definitions are valid.
+func BuildASTSchema(doc *ast.Document, options BuildSchemaOptions) (Schema, error) {
+	if doc == nil {
+		return Schema{}, gqlerrors.NewFormattedError("Must provide document")
+	}
+	return newSchemaBuilder(nil, options).build(doc)
+}
+
+type schemaBuilder struct {
+	// base is the schema being extended, nil when building a new schema
+	base *Schema
+
+	options    BuildSchemaOptions
+	typeDefs   map[string]ast.Node
+	typeNames  []string
+	extensions map[string][]ast.Node
+	types      map[string]Type
+
+	// fieldTransforms maps directive names to the transforms applied to the
+	// fields of the base schema, nil unless transforming a schema
+	fieldTransforms map[string]FieldTransformFn
+
+	// errors found in the definitions and options, reported together
+	errs []error
+}
+
+func newSchemaBuilder(base *Schema, options BuildSchemaOptions) *schemaBuilder {
+	return &schemaBuilder{
+		base:       base,
+		options:    options,
+		typeDefs:   map[string]ast.Node{},
+		extensions: map[string][]ast.Node{},
+		types:      map[string]Type{},
+	}
+}
+
+func (b *schemaBuilder) build(doc *ast.Document) (Schema, error) {
+	var schemaDef *ast.SchemaDefinition
+	schemaExts := []*ast.SchemaDefinition{}
+	directiveDefs := []*ast.DirectiveDefinition{}
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.SchemaDefinition:
+			if b.base != nil {
+				b.errs = append(b.errs, newSDLError("Cannot define a new schema within a schema extension.", def))
+				continue
+			}
+			if schemaDef != nil {
+				b.errs = append(b.errs, newSDLError("Must provide only one schema definition.", def))
+				continue
+			}
+			schemaDef = def
+		case *ast.SchemaExtensionDefinition:
+			if def.Definition != nil {
+				schemaExts = append(schemaExts, def.Definition)
+			}
+		case *ast.ScalarDefinition, *ast.ObjectDefinition, *ast.InterfaceDefinition,
+			*ast.UnionDefinition, *ast.EnumDefinition, *ast.InputObjectDefinition:
+			name := typeDefinitionName(def)
+			if _, ok := specifiedScalarTypes[name]; ok {
+				// redefining a specified scalar is allowed but has no effect
+				continue
+			}
+			if b.base != nil && b.base.Type(name) != nil {
+				b.errs = append(b.errs, newSDLError(fmt.Sprintf(`Type "%v" already exists in the schema. It cannot also be defined in this type definition.`, name), def))
+				continue
+			}
+			if _, ok := b.typeDefs[name]; ok {
+				b.errs = append(b.errs, newSDLError(fmt.Sprintf(`Type "%v" was defined more than once.`, name), def))
+				continue
+			}
+			b.typeDefs[name] = def
+			b.typeNames = append(b.typeNames, name)
+		case *ast.TypeExtensionDefinition, *ast.ScalarExtensionDefinition, *ast.InterfaceExtensionDefinition,
+			*ast.UnionExtensionDefinition, *ast.EnumExtensionDefinition, *ast.InputObjectExtensionDefinition:
+			if name := typeDefinitionName(extendedDefinition(def)); name != "" {
+				b.extensions[name] = append(b.extensions[name], def)
+			}
+		case *ast.DirectiveDefinition:
+			directiveDefs = append(directiveDefs, def)
+		default:
+			if b.base != nil {
+				b.errs = append(b.errs, newSDLError(fmt.Sprintf("Cannot extend a schema with a document containing a %v", def.GetKind()), def))
+				continue
+			}
+			b.errs = append(b.errs, newSDLError(fmt.Sprintf("GraphQL cannot build a schema from a document containing a %v", def.GetKind()), def))
+		}
+	}
+
+	b.assertValidExtensions(doc)
+	b.assertValidReferences(doc)
+	b.assertValidOptions()
+	// types are built from valid definitions only
+	if err := b.err(); err != nil {
+		return Schema{}, err
+	}
+	if b.base != nil {
+		b.extendTypes()
+	}
+
+	operationTypes := map[string]*ast.Named{}
+	if schemaDef != nil {
+		schemaExts = append([]*ast.SchemaDefinition{schemaDef}, schemaExts...)
+	}
+	for _, def := range schemaExts {
+		for _, opType := range def.OperationTypes {
+			if _, ok := operationTypes[opType.Operation]; ok || b.baseOperationType(opType.Operation) != nil {
+				b.errs = append(b.errs, newSDLError(fmt.Sprintf(`Must provide only one %v type in schema.`, opType.Operation), opType))
+				continue
+			}
+			operationTypes[opType.Operation] = opType.Type
+		}
+	}
+
+	useDefaultNames := schemaDef == nil && b.base == nil
+	queryType := b.operationType(ast.OperationTypeQuery, "Query", operationTypes, useDefaultNames)
+	mutationType := b.operationType(ast.OperationTypeMutation, "Mutation", operationTypes, useDefaultNames)
+	subscriptionType := b.operationType(ast.OperationTypeSubscription, "Subscription", operationTypes, useDefaultNames)
+	if queryType == nil {
+		if err := b.err(); err != nil {
+			return Schema{}, err
+		}
+		return Schema{}, gqlerrors.NewFormattedError("Must provide schema definition with query type or a type named Query.")
+	}
+
+	types := []Type{}
+	if b.base != nil {
+		// types of the base schema keep a stable order ahead of the new ones
+		baseTypeNames := []string{}
+		for name := range b.types {
+			baseTypeNames = append(baseTypeNames, name)
+		}
+		sort.Strings(baseTypeNames)
+		for _, name := range baseTypeNames {
+			types = append(types, b.types[name])
+		}
+	}
+	for _, name := range b.typeNames {
+		types = append(types, b.namedType(name))
+	}
+
+	directives := []*Directive{}
+	definedDirectives := map[string]bool{}
+	if b.base != nil {
+		for _, dir := range b.base.Directives() {
+			definedDirectives[dir.Name] = true
+			directives = append(directives, b.extendDirective(dir))
+		}
+	}
+	for _, def := range directiveDefs {
+		name := def.Name.Value
+		if definedDirectives[name] {
+			if b.base != nil {
+				b.errs = append(b.errs, newSDLError(fmt.Sprintf(`Directive "@%v" already exists in the schema. It cannot be redefined.`, name), def))
+				continue
+			}
+			b.errs = append(b.errs, newSDLError(fmt.Sprintf(`Directive "@%v" was defined more than once.`, name), def))
+			continue
+		}
+		definedDirectives[name] = true
+		directives = append(directives, b.buildDirective(def))
+	}
+	if b.base == nil {
+		for _, dir := range SpecifiedDirectives {
+			if !definedDirectives[dir.Name] {
+				directives = append(directives, dir)
+			}
+		}
+	}
+
+	extensions := b.options.Extensions
+	if b.base != nil {
+		extensions = append(append([]Extension{}, b.base.extensions...), b.options.Extensions...)
+	}
+
+	schema, err := NewSchema(SchemaConfig{
+		Query:        queryType,
+		Mutation:     mutationType,
+		Subscription: subscriptionType,
+		Types:        types,
+		Directives:   directives,
+		Extensions:   extensions,
+	})
+	if err != nil {
+		b.errs = append(b.errs, err)
+	}
+	return schema, b.err()
+}
+
+// err returns the error reported for the definitions so far, which joins all
+// errors if there are several.
+func (b *schemaBuilder) err() error {
+	switch len(b.errs) {
+	case 0:
+		return nil
+	case 1:
+		return b.errs[0]
+	}
+	return errors.Join(b.errs...)
+}
+
+func newSDLError(message string, node ast.Node) *gqlerrors.Error {
+	return gqlerrors.NewError(message, []ast.Node{node}, "", nil, []int{}, nil)
+}
+
+func typeDefinitionName(def ast.Node) string {
+	var name *ast.Name
+	switch def := def.(type) {
+	case *ast.ScalarDefinition:
+		name = def.Name
+	case *ast.ObjectDefinition:
+		name = def.Name
+	case *ast.InterfaceDefinition:
+		name = def.Name
+	case *ast.UnionDefinition:
+		name = def.Name
+	case *ast.EnumDefinition:
+		name = def.Name
+	case *ast.InputObjectDefinition:
+		name = def.Name
+	}
+	if name == nil {
+		return ""
+	}
+	return name.Value
+}
+
+// extendedDefinition returns the definition holding the additions of a type extension.
+func extendedDefinition(ext ast.Node) ast.Node {
+	switch ext := ext.(type) {
+	case *ast.TypeExtensionDefinition:
+		if ext.Definition != nil {
+			return ext.Definition
+		}
+	case *ast.ScalarExtensionDefinition:
+		if ext.Definition != nil {
+			return ext.Definition
+		}
+	case *ast.InterfaceExtensionDefinition:
+		if ext.Definition != nil {
+			return ext.Definition
+		}
+	case *ast.UnionExtensionDefinition:
+		if ext.Definition != nil {
+			return ext.Definition
+		}
+	case *ast.EnumExtensionDefinition:
+		if ext.Definition != nil {
+			return ext.Definition
+		}
+	case *ast.InputObjectExtensionDefinition:
+		if ext.Definition != nil {
+			return ext.Definition
+		}
+	}
+	return nil
+}
+
+// typeDefinitionKind returns the TypeKind of a type definition.
+func typeDefinitionKind(def ast.Node) string {
+	switch def.(type) {
+	case *ast.ScalarDefinition:
+		return TypeKindScalar
+	case *ast.ObjectDefinition:
+		return TypeKindObject
+	case *ast.InterfaceDefinition:
+		return TypeKindInterface
+	case *ast.UnionDefinition:
+		return TypeKindUnion
+	case *ast.EnumDefinition:
+		return TypeKindEnum
+	case *ast.InputObjectDefinition:
+		return TypeKindInputObject
+	}
+	return ""
+}
+
+// typeKind returns the TypeKind of a named type defined in the document or in the base schema.
+func (b *schemaBuilder) typeKind(name string) string {
+	if _, ok := specifiedScalarTypes[name]; ok {
+		return TypeKindScalar
+	}
+	if def, ok := b.typeDefs[name]; ok {
+		return typeDefinitionKind(def)
+	}
+	if b.base == nil {
+		return ""
+	}
+	switch b.base.Type(name).(type) {
+	case *Scalar:
+		return TypeKindScalar
+	case *Object:
+		return TypeKindObject
+	case *Interface:
+		return TypeKindInterface
+	case *Union:
+		return TypeKindUnion
+	case *Enum:
+		return TypeKindEnum
+	case *InputObject:
+		return TypeKindInputObject
+	}
+	return ""
+}
+
+var extensionKindNames = map[string]string{
+	TypeKindScalar:      "scalar",
+	TypeKindObject:      "object",
+	TypeKindInterface:   "interface",
+	TypeKindUnion:       "union",
+	TypeKindEnum:        "enum",
+	TypeKindInputObject: "input object",
+}
+
+// assertValidExtensions ensures every type extension extends a defined type of the same kind.
+func (b *schemaBuilder) assertValidExtensions(doc *ast.Document) {
+	for _, def := range doc.Definitions {
+		extended := extendedDefinition(def)
+		name := typeDefinitionName(extended)
+		if name == "" {
+			continue
+		}
+		kind := typeDefinitionKind(extended)
+		switch targetKind := b.typeKind(name); {
+		case targetKind == "":
+			b.errs = append(b.errs, newSDLError(fmt.Sprintf(`Cannot extend type "%v" because it is not defined.`, name), extended))
+		case targetKind != kind:
+			b.errs = append(b.errs, newSDLError(fmt.Sprintf(`Cannot extend non-%v type "%v".`, extensionKindNames[kind], name), extended))
+		default:
+			if _, ok := specifiedScalarTypes[name]; ok {
+				b.errs = append(b.errs, newSDLError(fmt.Sprintf(`Cannot extend specified scalar "%v".`, name), extended))
+			}
+		}
+	}
+}
+
+// assertValidReferences ensures every named type referenced in the document is defined.
+func (b *schemaBuilder) assertValidReferences(doc *ast.Document) {
+	for _, def := range doc.Definitions {
+		if extended := extendedDefinition(def); extended != nil {
+			def = extended
+		}
+		if ext, ok := def.(*ast.SchemaExtensionDefinition); ok && ext.Definition != nil {
+			def = ext.Definition
+		}
+		b.assertValidDefinitionReferences(def)
+	}
+}
+
+func (b *schemaBuilder) assertValidDefinitionReferences(def ast.Node) {
+	assertNamed := func(named *ast.Named) {
+		if named == nil || named.Name == nil {
+			return
+		}
+		if !b.hasType(named.Name.Value) {
+			b.errs = append(b.errs, newSDLError(fmt.Sprintf(`Unknown type "%v".`, named.Name.Value), named))
+		}
+	}
+	assertNamedList := func(namedList []*ast.Named) {
+		for _, named := range namedList {
+			assertNamed(named)
+		}
+	}
+	assertInputValues := func(values []*ast.InputValueDefinition) {
+		for _, value := range values {
+			assertNamed(namedTypeAST(value.Type))
+		}
+	}
+	assertFields := func(fields []*ast.FieldDefinition) {
+		for _, field := range fields {
+			assertNamed(namedTypeAST(field.Type))
+			assertInputValues(field.Arguments)
+		}
+	}
+
+	switch def := def.(type) {
+	case *ast.SchemaDefinition:
+		for _, opType := range def.OperationTypes {
+			assertNamed(opType.Type)
+		}
+	case *ast.ObjectDefinition:
+		assertNamedList(def.Interfaces)
+		assertFields(def.Fields)
+	case *ast.InterfaceDefinition:
+		assertNamedList(def.Interfaces)
+		assertFields(def.Fields)
+	case *ast.UnionDefinition:
+		assertNamedList(def.Types)
+	case *ast.InputObjectDefinition:
+		assertInputValues(def.Fields)
+	case *ast.DirectiveDefinition:
+		assertInputValues(def.Arguments)
+	}
+}
+
+// assertValidOptions ensures every coordinate in the options refers to a definition in the schema.
+func (b *schemaBuilder) assertValidOptions() {
+	for _, coordinate := range sortedKeys(b.options.Resolvers) {
+		if !b.hasField(coordinate) {
+			b.errs = append(b.errs, fmt.Errorf(`resolver "%v" does not match a field in the schema`, coordinate))
+		}
+	}
+	for _, coordinate := range sortedKeys(b.options.Subscribers) {
+		if !b.hasField(coordinate) {
+			b.errs = append(b.errs, fmt.Errorf(`subscriber "%v" does not match a field in the schema`, coordinate))
+		}
+	}
+	for _, name := range sortedKeys(b.options.ResolveType) {
+		if kind := b.typeKind(name); kind != TypeKindInterface && kind != TypeKindUnion {
+			b.errs = append(b.errs, fmt.Errorf(`ResolveType "%v" does not match an interface or union in the schema`, name))
+		}
+	}
+	for _, name := range sortedKeys(b.options.IsTypeOf) {
+		if b.typeKind(name) != TypeKindObject {
+			b.errs = append(b.errs, fmt.Errorf(`IsTypeOf "%v" does not match an object type in the schema`, name))
+		}
+	}
+	for _, name := range sortedKeys(b.options.Scalars) {
+		if _, ok := b.typeDefs[name].(*ast.ScalarDefinition); !ok {
+			b.errs = append(b.errs, fmt.Errorf(`scalar "%v" does not match a scalar in the schema`, name))
+		}
+	}
+	for _, coordinate := range sortedKeys(b.options.EnumValues) {
+		if !b.hasEnumValueDefinition(coordinate) {
+			b.errs = append(b.errs, fmt.Errorf(`enum value "%v" does not match an enum value in the schema`, coordinate))
+		}
+	}
+}
+
+// hasField reports whether the coordinate refers to a field defined in the document or in the base schema.
+func (b *schemaBuilder) hasField(coordinate string) bool {
+	typeName, fieldName, ok := strings.Cut(coordinate, ".")
+	if !ok {
+		return false
+	}
+	fields := b.extensionFields(typeName, nil)
+	switch def := b.typeDefs[typeName].(type) {
+	case *ast.ObjectDefinition:
+		fields = append(fields, def.Fields...)
+	case *ast.InterfaceDefinition:
+		fields = append(fields, def.Fields...)
+	}
+	for _, field := range fields {
+		if field.Name != nil && field.Name.Value == fieldName {
+			return true
+		}
+	}
+	if b.base == nil {
+		return false
+	}
+	switch ttype := b.base.Type(typeName).(type) {
+	case *Object:
+		_, ok = ttype.Fields()[fieldName]
+	case *Interface:
+		_, ok = ttype.Fields()[fieldName]
+	default:
+		ok = false
+	}
+	return ok
+}
+
+// hasEnumValueDefinition reports whether the coordinate refers to an enum value defined in the document.
+// Enum values of the base schema keep their internal values.
+func (b *schemaBuilder) hasEnumValueDefinition(coordinate string) bool {
+	typeName, valueName, ok := strings.Cut(coordinate, ".")
+	if !ok {
+		return false
+	}
+	values := b.extensionEnumValues(typeName, nil)
+	if def, ok := b.typeDefs[typeName].(*ast.EnumDefinition); ok {
+		values = append(values, def.Values...)
+	}
+	for _, value := range values {
+		if value.Name != nil && value.Name.Value == valueName {
+			return true
+		}
+	}
+	return false
+}
+
+func namedTypeAST(ttype ast.Type) *ast.Named {
+	for {
+		switch t := ttype.(type) {
+		case *ast.List:
+			ttype = t.Type
+		case *ast.NonNull:
+			ttype = t.Type
+		case *ast.Named:
+			return t
+		default:
+			return nil
+		}
+	}
+}
+
+func (b *schemaBuilder) hasType(name string) bool {
+	if _, ok := specifiedScalarTypes[name]; ok {
+		return true
+	}
+	if _, ok := b.typeDefs[name]; ok {
+		return true
+	}
+	return b.base != nil && b.base.Type(name) != nil
+}
+
+// extensionFields returns the defined fields followed by the fields added by extensions of the named type.
+func (b *schemaBuilder) extensionFields(name string, defined []*ast.FieldDefinition) []*ast.FieldDefinition {
+	fields := append([]*ast.FieldDefinition{}, defined...)
+	for _, ext := range b.extensions[name] {
+		switch ext := ext.(type) {
+		case *ast.TypeExtensionDefinition:
+			fields = append(fields, ext.Definition.Fields...)
+		case *ast.InterfaceExtensionDefinition:
+			fields = append(fields, ext.Definition.Fields...)
+		}
+	}
+	return fields
+}
+
+func (b *schemaBuilder) extensionInterfaces(name string, defined []*ast.Named) []*ast.Named {
+	interfaces := append([]*ast.Named{}, defined...)
+	for _, ext := range b.extensions[name] {
+		switch ext := ext.(type) {
+		case *ast.TypeExtensionDefinition:
+			interfaces = append(interfaces, ext.Definition.Interfaces...)
+		case *ast.InterfaceExtensionDefinition:
+			interfaces = append(interfaces, ext.Definition.Interfaces...)
+		}
+	}
+	return interfaces
+}
+
+func (b *schemaBuilder) extensionUnionMembers(name string, defined []*ast.Named) []*ast.Named {
+	members := append([]*ast.Named{}, defined...)
+	for _, ext := range b.extensions[name] {
+		if ext, ok := ext.(*ast.UnionExtensionDefinition); ok {
+			members = append(members, ext.Definition.Types...)
+		}
+	}
+	return members
+}
+
+func (b *schemaBuilder) extensionEnumValues(name string, defined []*ast.EnumValueDefinition) []*ast.EnumValueDefinition {
+	values := append([]*ast.EnumValueDefinition{}, defined...)
+	for _, ext := range b.extensions[name] {
+		if ext, ok := ext.(*ast.EnumExtensionDefinition); ok {
+			values = append(values, ext.Definition.Values...)
+		}
+	}
+	return values
+}
+
+func (b *schemaBuilder) extensionDirectives(name string, defined []*ast.Directive) []*ast.Directive {
+	directives := append([]*ast.Directive{}, defined...)
+	for _, ext := range b.extensions[name] {
+		switch ext := ext.(type) {
+		case *ast.ScalarExtensionDefinition:
+			directives = append(directives, ext.Definition.Directives...)
+		case *ast.TypeExtensionDefinition:
+			directives = append(directives, ext.Definition.Directives...)
+		case *ast.InterfaceExtensionDefinition:
+			directives = append(directives, ext.Definition.Directives...)
+		case *ast.UnionExtensionDefinition:
+			directives = append(directives, ext.Definition.Directives...)
+		case *ast.EnumExtensionDefinition:
+			directives = append(directives, ext.Definition.Directives...)
+		case *ast.InputObjectExtensionDefinition:
+			directives = append(directives, ext.Definition.Directives...)
+		}
+	}
+	return directives
+}
+
+func (b *schemaBuilder) extensionInputFields(name string, defined []*ast.InputValueDefinition) []*ast.InputValueDefinition {
+	fields := append([]*ast.InputValueDefinition{}, defined...)
+	for _, ext := range b.extensions[name] {
+		if ext, ok := ext.(*ast.InputObjectExtensionDefinition); ok {
+			fields = append(fields, ext.Definition.Fields...)
+		}
+	}
+	return fields
+}
+
+// operationType returns the root type of an operation declared by the schema definition and
+// its extensions, inherited from the base schema or, if useDefaultName is set, found by its default name.
+func (b *schemaBuilder) operationType(operation, defaultName string, operationTypes map[string]*ast.Named, useDefaultName bool) *Object {
+	named, ok := operationTypes[operation]
+	if !ok {
+		if baseType := b.baseOperationType(operation); baseType != nil {
+			return b.types[baseType.Name()].(*Object)
+		}
+		if !useDefaultName {
+			return nil
+		}
+		if _, ok := b.typeDefs[defaultName]; !ok {
+			return nil
+		}
+		named = ast.NewNamed(&ast.Named{Name: ast.NewName(&ast.Name{Value: defaultName})})
+	}
+	object, ok := b.namedType(named.Name.Value).(*Object)
+	if !ok {
+		b.errs = append(b.errs, newSDLError(fmt.Sprintf(`%v root type must be Object type but got: %v.`, operation, named.Name.Value), named))
+		return nil
+	}
+	return object
+}
+
+func (b *schemaBuilder) baseOperationType(operation string) *Object {
+	if b.base == nil {
+		return nil
+	}
+	switch operation {
+	case ast.OperationTypeQuery:
+		return b.base.QueryType()
+	case ast.OperationTypeMutation:
+		return b.base.MutationType()
+	case ast.OperationTypeSubscription:
+		return b.base.SubscriptionType()
+	}
+	return nil
+}
+
+func (b *schemaBuilder) namedType(name string) Type {
+	if ttype, ok := specifiedScalarTypes[name]; ok {
+		return ttype
+	}
+	if ttype, ok := b.types[name]; ok {
+		return ttype
+	}
+	var ttype Type
+	switch def := b.typeDefs[name].(type) {
+	case *ast.ScalarDefinition:
+		ttype = b.buildScalar(def)
+	case *ast.ObjectDefinition:
+		ttype = b.buildObject(def)
+	case *ast.InterfaceDefinition:
+		ttype = b.buildInterface(def)
+	case *ast.UnionDefinition:
+		ttype = b.buildUnion(def)
+	case *ast.EnumDefinition:
+		ttype = b.buildEnum(def)
+	case *ast.InputObjectDefinition:
+		ttype = b.buildInputObject(def)
+	}
+	b.types[name] = ttype
+	return ttype
+}
+
+func (b *schemaBuilder) buildType(ttype ast.Type) Type {
+	switch ttype := ttype.(type) {
+	case *ast.List:
+		return NewList(b.buildType(ttype.Type))
+	case *ast.NonNull:
+		return NewNonNull(b.buildType(ttype.Type))
+	case *ast.Named:
+		return b.namedType(ttype.Name.Value)
+	}
+	return nil
+}
+
+func (b *schemaBuilder) buildScalar(def *ast.ScalarDefinition) *Scalar {
+	name := def.Name.Value
+	config, ok := b.options.Scalars[name]
+	if !ok {
+		if scalar, ok := knownScalarTypes[name]; ok {
+			return scalar
+		}
+		config = ScalarConfig{
+			Serialize:    identityValue,
+			ParseValue:   identityValue,
+			ParseLiteral: valueFromUntypedAST,
+		}
+	}
+	if config.Name == "" {
+		config.Name = name
+	}
+	if config.Description == "" {
+		config.Description = descriptionValue(def)
+	}
+	directives := b.extensionDirectives(name, def.Directives)
+	if config.SpecifiedByURL == "" {
+		config.SpecifiedByURL = specifiedByURL(directives)
+	}
+	if config.AppliedDirectives == nil {
+		config.AppliedDirectives = b.appliedDirectives(directives)
+	}
+	return NewScalar(config)
+}
+
+func (b *schemaBuilder) buildObject(def *ast.ObjectDefinition) *Object {
+	name := def.Name.Value
+	return NewObject(ObjectConfig{
+		Name:              name,
+		Description:       descriptionValue(def),
+		IsTypeOf:          b.options.IsTypeOf[name],
+		AppliedDirectives: b.appliedDirectives(b.extensionDirectives(name, def.Directives)),
+		Interfaces: InterfacesThunk(func() []*Interface {
+			return b.buildInterfaces(name, b.extensionInterfaces(name, def.Interfaces))
+		}),
+		Fields: FieldsThunk(func() Fields {
+			return b.buildFields(name, b.extensionFields(name, def.Fields))
+		}),
+	})
+}
+
+func (b *schemaBuilder) buildInterfaces(typeName string, namedIfaces []*ast.Named) []*Interface {
+	ifaces := []*Interface{}
+	for _, named := range namedIfaces {
+		iface, ok := b.namedType(named.Name.Value).(*Interface)
+		if !ok {
+			b.errs = append(b.errs, newSDLError(fmt.Sprintf(`%v may only implement Interface types, it cannot implement: %v.`, typeName, named.Name.Value), named))
+			continue
+		}
+		ifaces = append(ifaces, iface)
+	}
+	return ifaces
+}
+
+func (b *schemaBuilder) buildInterface(def *ast.InterfaceDefinition) *Interface {
+	name := def.Name.Value
+	return NewInterface(InterfaceConfig{
+		Name:              name,
+		Description:       descriptionValue(def),
+		ResolveType:       b.resolveTypeFn(name),
+		AppliedDirectives: b.appliedDirectives(b.extensionDirectives(name, def.Directives)),
+		Interfaces: InterfacesThunk(func() []*Interface {
+			return b.buildInterfaces(name, b.extensionInterfaces(name, def.Interfaces))
+		}),
+		Fields: FieldsThunk(func() Fields {
+			return b.buildFields(name, b.extensionFields(name, def.Fields))
+		}),
+	})
+}
+
+func (b *schemaBuilder) buildFields(typeName string, defs []*ast.FieldDefinition) Fields {
+	return b.addFields(typeName, Fields{}, defs)
+}
+
+func (b *schemaBuilder) addFields(typeName string, fields Fields, defs []*ast.FieldDefinition) Fields {
+	for _, def := range defs {
+		fieldName := def.Name.Value
+		if _, ok := fields[fieldName]; ok {
+			b.errs = append(b.errs, newSDLError(fmt.Sprintf(`Field "%v.%v" can only be defined once.`, typeName, fieldName), def.Name))
+			continue
+		}
+		coordinate := typeName + "." + fieldName
+		fields[fieldName] = &Field{
+			Name:              fieldName,
+			Description:       descriptionValue(def),
+			Type:              b.buildType(def.Type),
+			Args:              b.buildArgs(def.Arguments),
+			Resolve:           b.options.Resolvers[coordinate],
+			Subscribe:         b.options.Subscribers[coordinate],
+			DeprecationReason: deprecationReason(def.Directives),
+			AppliedDirectives: b.appliedDirectives(def.Directives),
+		}
+	}
+	return fields
+}
+
+func (b *schemaBuilder) buildArgs(defs []*ast.InputValueDefinition) FieldConfigArgument {
+	args := FieldConfigArgument{}
+	for _, def := range defs {
+		ttype := b.buildType(def.Type)
+		args = append(args, &ArgumentConfig{
+			Name:              def.Name.Value,
+			Description:       descriptionValue(def),
+			Type:              ttype,
+			DefaultValue:      b.defaultValue(def, ttype),
+			DeprecationReason: deprecationReason(def.Directives),
+			AppliedDirectives: b.appliedDirectives(def.Directives),
+		})
+	}
+	return args
+}
+
+func (b *schemaBuilder) defaultValue(def *ast.InputValueDefinition, ttype Input) any {
+	if def.DefaultValue == nil {
+		return nil
+	}
+	value, err := valueFromAST(def.DefaultValue, ttype, nil)
+	if err != nil {
+		b.errs = append(b.errs, newSDLError(fmt.Sprintf(`Invalid default value for "%v": %v`, def.Name.Value, err), def.DefaultValue))
+		return nil
+	}
+	return value
+}
+
+func (b *schemaBuilder) buildUnion(def *ast.UnionDefinition) *Union {
+	name := def.Name.Value
+	return NewUnion(UnionConfig{
+		Name:              name,
+		Description:       descriptionValue(def),
+		ResolveType:       b.resolveTypeFn(name),
+		AppliedDirectives: b.appliedDirectives(b.extensionDirectives(name, def.Directives)),
+		Types: UnionTypesThunk(func() []*Object {
+			return b.buildUnionMembers(name, b.extensionUnionMembers(name, def.Types))
+		}),
+	})
+}
+
+func (b *schemaBuilder) buildUnionMembers(typeName string, members []*ast.Named) []*Object {
+	types := []*Object{}
+	for _, named := range members {
+		object, ok := b.namedType(named.Name.Value).(*Object)
+		if !ok {
+			b.errs = append(b.errs, newSDLError(fmt.Sprintf(`%v may only contain Object types, it cannot contain: %v.`, typeName, named.Name.Value), named))
+			continue
+		}
+		types = append(types, object)
+	}
+	return types
+}
+
+func (b *schemaBuilder) buildEnum(def *ast.EnumDefinition) *Enum {
+	name := def.Name.Value
+	return NewEnum(EnumConfig{
+		Name:              name,
+		Description:       descriptionValue(def),
+		Values:            b.addEnumValues(name, EnumValueConfigMap{}, b.extensionEnumValues(name, def.Values)),
+		AppliedDirectives: b.appliedDirectives(b.extensionDirectives(name, def.Directives)),
+	})
+}
+
+func (b *schemaBuilder) addEnumValues(typeName string, values EnumValueConfigMap, defs []*ast.EnumValueDefinition) EnumValueConfigMap {
+	for _, valueDef := range defs {
+		valueName := valueDef.Name.Value
+		if _, ok := values[valueName]; ok {
+			b.errs = append(b.errs, newSDLError(fmt.Sprintf(`Enum value "%v.%v" can only be defined once.`, typeName, valueName), valueDef.Name))
+			continue
+		}
+		value, ok := b.options.EnumValues[typeName+"."+valueName]
+		if !ok {
+			value = valueName
+		}
+		values[valueName] = &EnumValueConfig{
+			Value:             value,
+			Description:       descriptionValue(valueDef),
+			DeprecationReason: deprecationReason(valueDef.Directives),
+			AppliedDirectives: b.appliedDirectives(valueDef.Directives),
+		}
+	}
+	return values
+}
+
+func (b *schemaBuilder) buildInputObject(def *ast.InputObjectDefinition) *InputObject {
+	name := def.Name.Value
+	directives := b.extensionDirectives(name, def.Directives)
+	return NewInputObject(InputObjectConfig{
+		Name:              name,
+		Description:       descriptionValue(def),
+		IsOneOf:           hasDirective(directives, OneOfDirective.Name),
+		AppliedDirectives: b.appliedDirectives(directives),
+		Fields: InputObjectConfigFieldMapThunk(func() InputObjectConfigFieldMap {
+			return b.addInputFields(name, InputObjectConfigFieldMap{}, b.extensionInputFields(name, def.Fields))
+		}),
+	})
+}
+
+func (b *schemaBuilder) addInputFields(typeName string, fields InputObjectConfigFieldMap, defs []*ast.InputValueDefinition) InputObjectConfigFieldMap {
+	for _, fieldDef := range defs {
+		fieldName := fieldDef.Name.Value
+		if _, ok := fields[fieldName]; ok {
+			b.errs = append(b.errs, newSDLError(fmt.Sprintf(`Field "%v.%v" can only be defined once.`, typeName, fieldName), fieldDef.Name))
+			continue
+		}
+		ttype := b.buildType(fieldDef.Type)
+		fields[fieldName] = &InputObjectFieldConfig{
+			Type:              ttype,
+			Description:       descriptionValue(fieldDef),
+			DefaultValue:      b.defaultValue(fieldDef, ttype),
+			DeprecationReason: deprecationReason(fieldDef.Directives),
+			AppliedDirectives: b.appliedDirectives(fieldDef.Directives),
+		}
+	}
+	return fields
+}
+
+func (b *schemaBuilder) buildDirective(def *ast.DirectiveDefinition) *Directive {
+	locations := []string{}
+	for _, location := range def.Locations {
+		locations = append(locations, location.Value)
+	}
+	return NewDirective(DirectiveConfig{
+		Name:         def.Name.Value,
+		Description:  descriptionValue(def),
+		Locations:    locations,
+		Args:         b.buildArgs(def.Arguments),
+		IsRepeatable: def.Repeatable,
+	})
+}
+
+func (b *schemaBuilder) resolveTypeFn(name string) ResolveTypeFn {
+	if resolveType, ok := b.options.ResolveType[name]; ok {
+		return resolveType
+	}
+	return resolveTypeByTypename
+}
+
+// resolveTypeByTypename resolves map values by their "__typename" key and falls back
+// to the IsTypeOf functions of the possible types.
+func resolveTypeByTypename(p ResolveTypeParams) *Object {
+	if value, ok := p.Value.(map[string]any); ok {
+		if typename, ok := value[TypeNameMetaFieldDef.Name].(string); ok {
+			if object, ok := p.Info.Schema.Type(typename).(*Object); ok {
+				return object
+			}
+		}
+	}
+	if abstractType, ok := GetNamed(p.Info.ReturnType).(Abstract); ok {
+		return defaultResolveTypeFn(p, abstractType)
+	}
+	return nil
+}
+
+func descriptionValue(node ast.DescribableNode) string {
+	if desc := node.GetDescription(); desc != nil {
+		return desc.Value
+	}
+	return ""
+}
+
+func deprecationReason(directives []*ast.Directive) string {
+	for _, directive := range directives {
+		if directive.Name == nil || directive.Name.Value != DeprecatedDirective.Name {
+			continue
+		}
+		for _, arg := range directive.Arguments {
+			if arg.Name == nil || arg.Name.Value != "reason" {
+				continue
+			}
+			if reason, ok := arg.Value.(*ast.StringValue); ok {
+				return reason.Value
+			}
+		}
+		return DefaultDeprecationReason
+	}
+	return ""
+}
+
+func hasDirective(directives []*ast.Directive, name string) bool {
+	for _, directive := range directives {
+		if directive.Name != nil && directive.Name.Value == name {
+			return true
+		}
+	}
+	return false
+}
+
+func specifiedByURL(directives []*ast.Directive) string {
+	for _, directive := range directives {
+		if directive.Name == nil || directive.Name.Value != SpecifiedByDirective.Name {
+			continue
+		}
+		for _, arg := range directive.Arguments {
+			if arg.Name == nil || arg.Name.Value != "url" {
+				continue
+			}
+			if url, ok := arg.Value.(*ast.StringValue); ok {
+				return url.Value
+			}
+		}
+	}
+	return ""
+}
+
+// appliedDirectives returns the directives applied in the SDL, except for the
+// specified directives which are represented by dedicated config fields.
+func (b *schemaBuilder) appliedDirectives(directives []*ast.Directive) []*AppliedDirective {
+	var applied []*AppliedDirective
+	for _, directive := range directives {
+		if directive.Name == nil || isSpecifiedDirectiveName(directive.Name.Value) {
+			continue
+		}
+		args := map[string]any{}
+		for _, arg := range directive.Arguments {
+			value, err := valueFromUntypedAST(arg.Value)
+			if err != nil {
+				b.errs = append(b.errs, newSDLError(fmt.Sprintf(`Invalid value for argument "%v" of directive "@%v": %v`, arg.Name.Value, directive.Name.Value, err), arg.Value))
+				continue
+			}
+			args[arg.Name.Value] = value
+		}
+		applied = append(applied, &AppliedDirective{Name: directive.Name.Value, Args: args})
+	}
+	return applied
+}
+
+func isSpecifiedDirectiveName(name string) bool {
+	for _, directive := range SpecifiedDirectives {
+		if directive.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func identityValue(value any) (any, error) {
+	return value, nil
+}
+
+// valueFromUntypedAST produces a Go value from a literal without knowing its type.
+func valueFromUntypedAST(valueAST ast.Value) (any, error) {
+	switch valueAST := valueAST.(type) {
+	case *ast.NullValue:
+		return nil, nil
+	case *ast.IntValue:
+		return strconv.ParseInt(valueAST.Value, 10, 64)
+	case *ast.FloatValue:
+		return strconv.ParseFloat(valueAST.Value, 64)
+	case *ast.StringValue:
+		return valueAST.Value, nil
+	case *ast.BooleanValue:
+		return valueAST.Value, nil
+	case *ast.EnumValue:
+		return valueAST.Value, nil
+	case *ast.ListValue:
+		values := []any{}
+		for _, itemAST := range valueAST.Values {
+			item, err := valueFromUntypedAST(itemAST)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, item)
+		}
+		return values, nil
+	case *ast.ObjectValue:
+		obj := map[string]any{}
+		for _, field := range valueAST.Fields {
+			value, err := valueFromUntypedAST(field.Value)
+			if err != nil {
+				return nil, err
+			}
+			obj[field.Name.Value] = value
+		}
+		return obj, nil
+	}
+	return nil, fmt.Errorf("cannot parse literal %T", valueAST)
+}
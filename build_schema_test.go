@@ -0,0 +1,404 @@
+package graphql_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/gqlerrors"
+	"github.com/fraym/graphql-go/language/ast"
+	"github.com/fraym/graphql-go/language/location"
+	"github.com/fraym/graphql-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+const buildSchemaTestSDL = `
+"""
+Root query
+"""
+type Query {
+  "Greets somebody"
+  hello(name: String = "World", times: Int = 1): String
+  pets: [Pet!]!
+  named: [Named]
+  episode(id: Episode!): String
+  oldField: String @deprecated
+}
+
+interface Named {
+  name: String
+}
+
+type Dog implements Named {
+  name: String
+  barks: Boolean
+}
+
+type Cat implements Named {
+  name: String
+  meows: Boolean
+}
+
+union Pet = Dog | Cat
+
+enum Episode {
+  NEWHOPE
+  EMPIRE @deprecated(reason: "Use NEWHOPE")
+}
+`
+
+func buildTestSchema(t *testing.T) graphql.Schema {
+	schema, err := graphql.BuildSchema(buildSchemaTestSDL, graphql.BuildSchemaOptions{
+		Resolvers: map[string]graphql.FieldResolveFn{
+			"Query.hello": func(p graphql.ResolveParams) (any, error) {
+				return fmt.Sprintf("Hello %v x%v", p.Args["name"], p.Args["times"]), nil
+			},
+			"Query.pets": func(p graphql.ResolveParams) (any, error) {
+				return []any{
+					map[string]any{"__typename": "Dog", "name": "Odie", "barks": true},
+					map[string]any{"__typename": "Cat", "name": "Garfield", "meows": false},
+				}, nil
+			},
+			"Query.named": func(p graphql.ResolveParams) (any, error) {
+				return []any{
+					map[string]any{"__typename": "Cat", "name": "Garfield"},
+				}, nil
+			},
+			"Query.episode": func(p graphql.ResolveParams) (any, error) {
+				if p.Args["id"] == 5 {
+					return "empire", nil
+				}
+				return "unknown", nil
+			},
+		},
+		EnumValues: map[string]any{
+			"Episode.NEWHOPE": 4,
+			"Episode.EMPIRE":  5,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return schema
+}
+
+func TestBuildSchema_ExecutesWithResolvers(t *testing.T) {
+	schema := buildTestSchema(t)
+
+	result := graphql.Do(graphql.Params{
+		Schema: schema,
+		RequestString: `{
+			hello
+			pets {
+				__typename
+				... on Dog { name barks }
+				... on Cat { name meows }
+			}
+			named { __typename name }
+			episode(id: EMPIRE)
+		}`,
+	})
+	if len(result.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+	expected := map[string]any{
+		"hello": "Hello World x1",
+		"pets": []any{
+			map[string]any{"__typename": "Dog", "name": "Odie", "barks": true},
+			map[string]any{"__typename": "Cat", "name": "Garfield", "meows": false},
+		},
+		"named": []any{
+			map[string]any{"__typename": "Cat", "name": "Garfield"},
+		},
+		"episode": "empire",
+	}
+	if !reflect.DeepEqual(expected, result.Data) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result.Data))
+	}
+}
+
+func TestBuildSchema_KeepsDescriptionsDeprecationsAndArgumentOrder(t *testing.T) {
+	schema := buildTestSchema(t)
+
+	query := schema.QueryType()
+	assert.Equal(t, "Root query", query.PrivateDescription)
+
+	hello := query.Fields()["hello"]
+	assert.Equal(t, "Greets somebody", hello.Description)
+	assert.Len(t, hello.Args, 2)
+	assert.Equal(t, "name", hello.Args[0].Name())
+	assert.Equal(t, "World", hello.Args[0].DefaultValue)
+	assert.Equal(t, "times", hello.Args[1].Name())
+	assert.Equal(t, int64(1), hello.Args[1].DefaultValue)
+
+	assert.Equal(t, graphql.DefaultDeprecationReason, query.Fields()["oldField"].DeprecationReason)
+
+	episode := schema.Type("Episode").(*graphql.Enum)
+	for _, value := range episode.Values() {
+		if value.Name == "EMPIRE" {
+			assert.Equal(t, "Use NEWHOPE", value.DeprecationReason)
+			assert.Equal(t, 5, value.Value)
+		}
+	}
+}
+
+func TestBuildSchema_UsesRootOperationTypesOfSchemaDefinition(t *testing.T) {
+	schema, err := graphql.BuildSchema(`
+		schema {
+			query: RootQuery
+			mutation: RootMutation
+		}
+
+		type RootQuery {
+			value: Int
+		}
+
+		type RootMutation {
+			setValue(value: Int!): Int
+		}
+	`, graphql.BuildSchemaOptions{
+		Resolvers: map[string]graphql.FieldResolveFn{
+			"RootMutation.setValue": func(p graphql.ResolveParams) (any, error) {
+				return p.Args["value"], nil
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, "RootQuery", schema.QueryType().Name())
+	assert.Equal(t, "RootMutation", schema.MutationType().Name())
+	assert.Nil(t, schema.SubscriptionType())
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `mutation { setValue(value: 42) }`,
+	})
+	if len(result.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+	assert.Equal(t, map[string]any{"setValue": int64(42)}, result.Data)
+}
+
+func TestBuildSchema_MergesObjectExtensions(t *testing.T) {
+	schema, err := graphql.BuildSchema(`
+		type Query {
+			a: String
+		}
+
+		extend type Query {
+			b: String
+		}
+	`, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	fields := schema.QueryType().Fields()
+	assert.Contains(t, fields, "a")
+	assert.Contains(t, fields, "b")
+}
+
+func TestBuildSchema_UsesScalarHooks(t *testing.T) {
+	schema, err := graphql.BuildSchema(`
+		"An odd number"
+		scalar Odd
+
+		scalar Anything
+
+		type Query {
+			odd(value: Odd): Odd
+			anything(value: Anything): Anything
+		}
+	`, graphql.BuildSchemaOptions{
+		Resolvers: map[string]graphql.FieldResolveFn{
+			"Query.odd": func(p graphql.ResolveParams) (any, error) {
+				return p.Args["value"], nil
+			},
+			"Query.anything": func(p graphql.ResolveParams) (any, error) {
+				return p.Args["value"], nil
+			},
+		},
+		Scalars: map[string]graphql.ScalarConfig{
+			"Odd": {
+				Serialize: func(value any) (any, error) {
+					return value, nil
+				},
+				ParseValue: func(value any) (any, error) {
+					return value, nil
+				},
+				ParseLiteral: func(valueAST ast.Value) (any, error) {
+					if v, ok := valueAST.(*ast.IntValue); ok && v.Value == "3" {
+						return 3, nil
+					}
+					return nil, nil
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, "An odd number", schema.Type("Odd").Description())
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ odd(value: 3) anything(value: {a: [1, "b"]}) }`,
+	})
+	if len(result.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+	expected := map[string]any{
+		"odd":      3,
+		"anything": map[string]any{"a": []any{int64(1), "b"}},
+	}
+	if !reflect.DeepEqual(expected, result.Data) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result.Data))
+	}
+}
+
+func TestBuildSchema_ReportsErrorsAtSDLLocation(t *testing.T) {
+	tests := []struct {
+		name     string
+		sdl      string
+		message  string
+		location location.SourceLocation
+	}{
+		{
+			name: "unknown type",
+			sdl: `
+type Query {
+  foo: Bar
+}`,
+			message:  `Unknown type "Bar".`,
+			location: location.SourceLocation{Line: 3, Column: 8},
+		},
+		{
+			name: "duplicate type",
+			sdl: `
+type Query {
+  foo: String
+}
+type Query {
+  bar: String
+}`,
+			message:  `Type "Query" was defined more than once.`,
+			location: location.SourceLocation{Line: 5, Column: 1},
+		},
+		{
+			name: "executable definition",
+			sdl: `
+type Query {
+  foo: String
+}
+{ foo }`,
+			message:  `GraphQL cannot build a schema from a document containing a OperationDefinition`,
+			location: location.SourceLocation{Line: 5, Column: 1},
+		},
+		{
+			name: "root type is not an object",
+			sdl: `
+schema {
+  query: Foo
+}
+input Foo {
+  bar: String
+}`,
+			message:  `query root type must be Object type but got: Foo.`,
+			location: location.SourceLocation{Line: 3, Column: 10},
+		},
+		{
+			name: "union member is not an object",
+			sdl: `
+type Query {
+  foo: Foo
+}
+union Foo = Query | Bar
+interface Bar {
+  bar: String
+}`,
+			message:  `Foo may only contain Object types, it cannot contain: Bar.`,
+			location: location.SourceLocation{Line: 5, Column: 21},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := graphql.BuildSchema(test.sdl, graphql.BuildSchemaOptions{})
+			gqlErr, ok := err.(*gqlerrors.Error)
+			if !ok {
+				t.Fatalf("expected *gqlerrors.Error, got %#v", err)
+			}
+			assert.Equal(t, test.message, gqlErr.Message)
+			assert.Equal(t, []location.SourceLocation{test.location}, gqlErr.Locations)
+		})
+	}
+}
+
+func TestBuildSchema_ReportsAllInvalidDefinitions(t *testing.T) {
+	_, err := graphql.BuildSchema(`
+type Query {
+  foo: String
+  foo: Int
+}
+union Foo = Query | Bar
+interface Bar {
+  bar: String
+}`, graphql.BuildSchemaOptions{})
+	assert.EqualError(t, err, "Field \"Query.foo\" can only be defined once.\n"+
+		"Foo may only contain Object types, it cannot contain: Bar.")
+	var gqlErr *gqlerrors.Error
+	if assert.True(t, errors.As(err, &gqlErr)) {
+		assert.Equal(t, []location.SourceLocation{{Line: 4, Column: 3}}, gqlErr.Locations)
+	}
+}
+
+func TestBuildSchema_ReportsAllInvalidDefinitionsBeforeBuilding(t *testing.T) {
+	_, err := graphql.BuildSchema(`
+type Query {
+  foo: Foo
+  bar: Bar
+}
+type Query {
+  baz: String
+}
+extend type Baz {
+  baz: String
+}`, graphql.BuildSchemaOptions{
+		Resolvers: map[string]graphql.FieldResolveFn{
+			"Query.unknown": func(p graphql.ResolveParams) (any, error) { return nil, nil },
+		},
+	})
+	assert.EqualError(t, err, "Type \"Query\" was defined more than once.\n"+
+		"Cannot extend type \"Baz\" because it is not defined.\n"+
+		"Unknown type \"Foo\".\n"+
+		"Unknown type \"Bar\".\n"+
+		"resolver \"Query.unknown\" does not match a field in the schema")
+
+	_, err = graphql.BuildSchema(`
+type Query {
+  foo: String
+}
+directive @foo on FIELD
+directive @foo on FIELD
+directive @bar on FIELD
+directive @bar on FIELD`, graphql.BuildSchemaOptions{})
+	assert.EqualError(t, err, "Directive \"@foo\" was defined more than once.\n"+
+		"Directive \"@bar\" was defined more than once.")
+}
+
+func TestBuildSchema_RejectsUnknownResolverCoordinates(t *testing.T) {
+	_, err := graphql.BuildSchema(`type Query { foo: String }`, graphql.BuildSchemaOptions{
+		Resolvers: map[string]graphql.FieldResolveFn{
+			"Query.bar": func(p graphql.ResolveParams) (any, error) {
+				return nil, nil
+			},
+		},
+	})
+	assert.EqualError(t, err, `resolver "Query.bar" does not match a field in the schema`)
+}
+
+func TestBuildSchema_RequiresQueryType(t *testing.T) {
+	_, err := graphql.BuildSchema(`type Foo { foo: String }`, graphql.BuildSchemaOptions{})
+	assert.EqualError(t, err, "Must provide schema definition with query type or a type named Query.")
+}
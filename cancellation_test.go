@@ -0,0 +1,488 @@
+package graphql_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/gqlerrors"
+	"github.com/fraym/graphql-go/language/location"
+	"github.com/fraym/graphql-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func newCancellationTestSchema(t *testing.T, fields graphql.Fields) graphql.Schema {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name:   "Query",
+			Fields: fields,
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return schema
+}
+
+func assertCancellationErrors(t *testing.T, expected, actual []gqlerrors.FormattedError) {
+	t.Helper()
+	if !testutil.EqualFormattedErrors(expected, actual) {
+		t.Fatalf("Unexpected errors, Diff: %v", testutil.Diff(expected, actual))
+	}
+}
+
+// resolverTracker checks that no resolver is called once the execution
+// returned.
+type resolverTracker struct {
+	t        *testing.T
+	returned chan struct{}
+}
+
+func newResolverTracker(t *testing.T) *resolverTracker {
+	return &resolverTracker{t: t, returned: make(chan struct{})}
+}
+
+func (r *resolverTracker) track(resolve graphql.FieldResolveFn) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (any, error) {
+		select {
+		case <-r.returned:
+			r.t.Errorf("%v was resolved after the execution returned", p.Info.Path.AsArray())
+		default:
+		}
+		return resolve(p)
+	}
+}
+
+func (r *resolverTracker) executionReturned() {
+	close(r.returned)
+}
+
+func TestExecute_StopsResolvingFieldsOnceContextIsDone(t *testing.T) {
+	var cancel context.CancelFunc
+	var tracker *resolverTracker
+	resolve := func(p graphql.ResolveParams) (any, error) {
+		return tracker.track(func(p graphql.ResolveParams) (any, error) {
+			return p.Info.FieldName, nil
+		})(p)
+	}
+	itemType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Item",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{Type: graphql.String, Resolve: resolve},
+		},
+	})
+	schema := newCancellationTestSchema(t, graphql.Fields{
+		"first": &graphql.Field{Type: graphql.String, Resolve: resolve},
+		"cancel": &graphql.Field{
+			Type: graphql.String,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return tracker.track(func(p graphql.ResolveParams) (any, error) {
+					// the value is discarded as the context is done
+					cancel()
+					return "cancel", nil
+				})(p)
+			},
+		},
+		"last": &graphql.Field{Type: graphql.String, Resolve: resolve},
+		"items": &graphql.Field{
+			Type: graphql.NewList(itemType),
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return tracker.track(func(p graphql.ResolveParams) (any, error) {
+					return []any{map[string]any{}, map[string]any{}}, nil
+				})(p)
+			},
+		},
+	})
+
+	for _, maxConcurrency := range []int{0, 4} {
+		t.Run(fmt.Sprintf("MaxConcurrency %d", maxConcurrency), func(t *testing.T) {
+			var ctx context.Context
+			ctx, cancel = context.WithCancel(context.Background())
+			defer cancel()
+			tracker = newResolverTracker(t)
+			result := graphql.Do(graphql.Params{
+				Schema:         schema,
+				RequestString:  `{ first cancel last items { name } }`,
+				Context:        ctx,
+				MaxConcurrency: maxConcurrency,
+			})
+			tracker.executionReturned()
+
+			data := result.Data.(map[string]any)
+			assert.Nil(t, data["cancel"])
+			assert.NotEmpty(t, result.Errors)
+			for _, err := range result.Errors {
+				assert.Equal(t, context.Canceled.Error(), err.Message)
+			}
+			if maxConcurrency == 0 {
+				assert.Equal(t, map[string]any{"first": "first", "cancel": nil, "last": nil, "items": nil}, data)
+				assertCancellationErrors(t, []gqlerrors.FormattedError{
+					{
+						Message:   context.Canceled.Error(),
+						Locations: []location.SourceLocation{{Line: 1, Column: 9}},
+						Path:      []any{"cancel"},
+					},
+					{
+						Message:   context.Canceled.Error(),
+						Locations: []location.SourceLocation{{Line: 1, Column: 16}},
+						Path:      []any{"last"},
+					},
+					{
+						Message:   context.Canceled.Error(),
+						Locations: []location.SourceLocation{{Line: 1, Column: 21}},
+						Path:      []any{"items"},
+					},
+				}, result.Errors)
+			}
+		})
+	}
+}
+
+func TestExecute_KeepsCompletedListItemsOnceContextIsDone(t *testing.T) {
+	var cancel context.CancelFunc
+	itemType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Item",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.String,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					if p.Source == 1 {
+						cancel()
+						return nil, p.Context.Err()
+					}
+					return fmt.Sprintf("item %v", p.Source), nil
+				},
+			},
+		},
+	})
+	resolveItems := func(p graphql.ResolveParams) (any, error) {
+		return []int{0, 1, 2, 3}, nil
+	}
+	schema := newCancellationTestSchema(t, graphql.Fields{
+		"other":        &graphql.Field{Type: graphql.String},
+		"items":        &graphql.Field{Type: graphql.NewList(itemType), Resolve: resolveItems},
+		"nonNullItems": &graphql.Field{Type: graphql.NewList(graphql.NewNonNull(itemType)), Resolve: resolveItems},
+	})
+
+	var ctx context.Context
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ other items { name } }`,
+		RootObject:    map[string]any{"other": "other"},
+		Context:       ctx,
+	})
+	assert.Equal(t, map[string]any{
+		"other": "other",
+		"items": []any{
+			map[string]any{"name": "item 0"},
+			map[string]any{"name": nil},
+			nil,
+			nil,
+		},
+	}, result.Data)
+	assertCancellationErrors(t, []gqlerrors.FormattedError{
+		{
+			Message:   context.Canceled.Error(),
+			Locations: []location.SourceLocation{{Line: 1, Column: 17}},
+			Path:      []any{"items", 1, "name"},
+		},
+		{
+			Message:   context.Canceled.Error(),
+			Locations: []location.SourceLocation{{Line: 1, Column: 9}},
+			Path:      []any{"items", 2},
+		},
+	}, result.Errors)
+
+	// non-null items which weren't completed null the list
+	ctx, cancel = context.WithCancel(context.Background())
+	defer cancel()
+	result = graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ other nonNullItems { name } }`,
+		RootObject:    map[string]any{"other": "other"},
+		Context:       ctx,
+	})
+	assert.Equal(t, map[string]any{"other": "other", "nonNullItems": nil}, result.Data)
+	assertCancellationErrors(t, []gqlerrors.FormattedError{
+		{
+			Message:   context.Canceled.Error(),
+			Locations: []location.SourceLocation{{Line: 1, Column: 24}},
+			Path:      []any{"nonNullItems", 1, "name"},
+		},
+		{
+			Message:   context.Canceled.Error(),
+			Locations: []location.SourceLocation{{Line: 1, Column: 9}},
+			Path:      []any{"nonNullItems", 2},
+		},
+	}, result.Errors)
+}
+
+func TestExecute_AbandonsResolversOnceContextIsDone(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	defer close(release)
+	var finished atomic.Bool
+	// ignoreContext signals that it was called and returns once released
+	ignoreContext := func() (any, error) {
+		started <- struct{}{}
+		<-release
+		finished.Store(true)
+		return "late", nil
+	}
+	tracker := newResolverTracker(t)
+	schema := newCancellationTestSchema(t, graphql.Fields{
+		"fast": &graphql.Field{Type: graphql.String},
+		"blocking": &graphql.Field{
+			Type: graphql.String,
+			Resolve: tracker.track(func(p graphql.ResolveParams) (any, error) {
+				return ignoreContext()
+			}),
+		},
+		"thunk": &graphql.Field{
+			Type: graphql.String,
+			Resolve: tracker.track(func(p graphql.ResolveParams) (any, error) {
+				return func() (any, error) {
+					return ignoreContext()
+				}, nil
+			}),
+		},
+	})
+
+	for _, query := range []string{`{ fast blocking }`, `{ fast thunk }`} {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		go func() {
+			<-started
+			cancel()
+		}()
+		result := graphql.Do(graphql.Params{
+			Schema:        schema,
+			RequestString: query,
+			RootObject:    map[string]any{"fast": "fast"},
+			Context:       ctx,
+		})
+		assert.False(t, finished.Load())
+
+		data := result.Data.(map[string]any)
+		assert.Equal(t, "fast", data["fast"])
+		assert.Len(t, data, 2)
+		if assert.Len(t, result.Errors, 1) {
+			assert.Equal(t, context.Canceled.Error(), result.Errors[0].Message)
+			assert.Len(t, result.Errors[0].Path, 1)
+		}
+	}
+	tracker.executionReturned()
+}
+
+func TestField_Timeout(t *testing.T) {
+	var deadlineSet atomic.Bool
+	release := make(chan struct{})
+	defer close(release)
+	// ignoreTimeout returns a value even though ctx is done
+	ignoreTimeout := func(ctx context.Context) (any, error) {
+		<-ctx.Done()
+		return "slow", nil
+	}
+
+	userType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{Type: graphql.String},
+			"status": &graphql.Field{
+				Type:    graphql.NewNonNull(graphql.String),
+				Timeout: time.Millisecond,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					_, ok := p.Context.Deadline()
+					deadlineSet.Store(ok)
+					<-p.Context.Done()
+					return nil, p.Context.Err()
+				},
+			},
+		},
+	})
+	schema := newCancellationTestSchema(t, graphql.Fields{
+		"fast": &graphql.Field{
+			Type:    graphql.String,
+			Timeout: time.Hour,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return "fast", nil
+			},
+		},
+		"ignoringContext": &graphql.Field{
+			Type:    graphql.String,
+			Timeout: time.Millisecond,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return ignoreTimeout(p.Context)
+			},
+		},
+		"thunk": &graphql.Field{
+			Type:    graphql.String,
+			Timeout: time.Millisecond,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return func() (any, error) {
+					return ignoreTimeout(p.Context)
+				}, nil
+			},
+		},
+		"user": &graphql.Field{
+			Type: userType,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return map[string]any{"name": "Ada"}, nil
+			},
+		},
+		"blocking": &graphql.Field{
+			Type:    graphql.String,
+			Timeout: time.Millisecond,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				<-release
+				return "slow", nil
+			},
+		},
+	})
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ fast ignoringContext thunk user { name status } blocking }`,
+	})
+	assert.True(t, deadlineSet.Load())
+	assert.Equal(t, map[string]any{
+		"fast":            "fast",
+		"ignoringContext": nil,
+		"thunk":           nil,
+		"user":            nil,
+		"blocking":        nil,
+	}, result.Data)
+	assertCancellationErrors(t, []gqlerrors.FormattedError{
+		{
+			Message:   "Query.ignoringContext timed out after 1ms",
+			Locations: []location.SourceLocation{{Line: 1, Column: 8}},
+			Path:      []any{"ignoringContext"},
+		},
+		{
+			Message:   "User.status timed out after 1ms",
+			Locations: []location.SourceLocation{{Line: 1, Column: 42}},
+			Path:      []any{"user", "status"},
+		},
+		{
+			Message:   "Query.blocking timed out after 1ms",
+			Locations: []location.SourceLocation{{Line: 1, Column: 51}},
+			Path:      []any{"blocking"},
+		},
+		{
+			Message:   "Query.thunk timed out after 1ms",
+			Locations: []location.SourceLocation{{Line: 1, Column: 24}},
+			Path:      []any{"thunk"},
+		},
+	}, result.Errors)
+}
+
+func TestField_TimeoutDiscardsResultsOnceRequestIsDone(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	schema := newCancellationTestSchema(t, graphql.Fields{
+		"slow": &graphql.Field{
+			Type:    graphql.String,
+			Timeout: time.Hour,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				cancel()
+				return "slow", nil
+			},
+		},
+	})
+
+	result := graphql.Do(graphql.Params{Schema: schema, RequestString: `{ slow }`, Context: ctx})
+	assert.Equal(t, map[string]any{"slow": nil}, result.Data)
+	assertCancellationErrors(t, []gqlerrors.FormattedError{
+		{
+			Message:   context.Canceled.Error(),
+			Locations: []location.SourceLocation{{Line: 1, Column: 3}},
+			Path:      []any{"slow"},
+		},
+	}, result.Errors)
+}
+
+func TestField_TimeoutsAreStoppedOnceExecutionReturns(t *testing.T) {
+	var thunkCtx context.Context
+	userType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type:    graphql.String,
+				Timeout: time.Hour,
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					thunkCtx = p.Context
+					return func() (any, error) {
+						return "Ada", nil
+					}, nil
+				},
+			},
+			"status": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return nil, errors.New("status is unknown")
+				},
+			},
+		},
+	})
+	schema := newCancellationTestSchema(t, graphql.Fields{
+		"user": &graphql.Field{
+			Type: userType,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				return map[string]any{}, nil
+			},
+		},
+	})
+
+	// the thunk of name is never called as user is nulled
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ user { name status } }`,
+	})
+	assert.Equal(t, map[string]any{"user": nil}, result.Data)
+	assert.Len(t, result.Errors, 1)
+	if assert.NotNil(t, thunkCtx) {
+		assert.ErrorIs(t, thunkCtx.Err(), context.Canceled)
+	}
+}
+
+func TestField_TimeoutErrorsWrapDeadlineExceeded(t *testing.T) {
+	var resolveErr error
+	ext := newtestExt("timeoutExt")
+	ext.resolveFieldDidStartFn = func(ctx context.Context, i *graphql.ResolveInfo) (context.Context, graphql.ResolveFieldFinishFunc) {
+		return ctx, func(v any, err error) {
+			if err != nil {
+				resolveErr = err
+			}
+		}
+	}
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"slow": &graphql.Field{
+					Type:    graphql.String,
+					Timeout: time.Millisecond,
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						<-p.Context.Done()
+						return nil, p.Context.Err()
+					},
+				},
+			},
+		}),
+		Extensions: []graphql.Extension{ext},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result := graphql.Do(graphql.Params{Schema: schema, RequestString: `{ slow }`})
+	assert.Len(t, result.Errors, 1)
+	assert.True(t, errors.Is(resolveErr, context.DeadlineExceeded))
+	assert.EqualError(t, resolveErr, "Query.slow timed out after 1ms")
+}
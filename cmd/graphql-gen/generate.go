@@ -0,0 +1,610 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/language/ast"
+	"github.com/fraym/graphql-go/language/parser"
+	"github.com/fraym/graphql-go/language/source"
+)
+
+type schemaFile struct {
+	Name string
+	Body []byte
+}
+
+// initialisms are written in upper case in Go names, e.g. the field userId
+// becomes UserID.
+var initialisms = map[string]bool{
+	"API":  true,
+	"HTML": true,
+	"HTTP": true,
+	"ID":   true,
+	"IP":   true,
+	"JSON": true,
+	"SQL":  true,
+	"URI":  true,
+	"URL":  true,
+	"UUID": true,
+	"XML":  true,
+}
+
+// generate returns the formatted Go code for the schema defined by the files.
+func generate(pkg string, files []schemaFile) ([]byte, error) {
+	doc := ast.NewDocument(nil)
+	sdl := []string{}
+	for _, file := range files {
+		fileDoc, err := parser.Parse(parser.ParseParams{
+			Source: source.NewSource(&source.Source{
+				Body: file.Body,
+				Name: file.Name,
+			}),
+		})
+		if err != nil {
+			return nil, err
+		}
+		doc.Definitions = append(doc.Definitions, fileDoc.Definitions...)
+		sdl = append(sdl, string(file.Body))
+	}
+
+	schema, err := graphql.BuildASTSchema(doc, graphql.BuildSchemaOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{
+		schema:  schema,
+		fields:  map[string][]string{},
+		roots:   map[string]bool{},
+		imports: map[string]bool{},
+	}
+	for _, root := range []*graphql.Object{schema.QueryType(), schema.MutationType(), schema.SubscriptionType()} {
+		if root != nil {
+			g.roots[root.Name()] = true
+		}
+	}
+	g.collectOrder(doc)
+	g.generateTypes()
+	g.generateSchema(strings.Join(sdl, "\n"))
+
+	code := &bytes.Buffer{}
+	fmt.Fprintf(code, "// Code generated by graphql-gen. DO NOT EDIT.\n\npackage %v\n\n", pkg)
+	imports := []string{}
+	for path := range g.imports {
+		imports = append(imports, path)
+	}
+	sort.Strings(imports)
+	code.WriteString("import (\n")
+	for _, path := range imports {
+		fmt.Fprintf(code, "%q\n", path)
+	}
+	code.WriteString("\n\"github.com/fraym/graphql-go\"\n)\n")
+	code.Write(g.buf.Bytes())
+
+	formatted, err := format.Source(code.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to format generated code: %w", err)
+	}
+	return formatted, nil
+}
+
+type generator struct {
+	schema graphql.Schema
+
+	// types are the names of the types defined by the schema files and fields
+	// the names of their fields, or enum values, in the order they are defined.
+	types  []string
+	fields map[string][]string
+
+	roots   map[string]bool
+	imports map[string]bool
+	buf     bytes.Buffer
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func (g *generator) collectOrder(doc *ast.Document) {
+	add := func(name *ast.Name, fields []string) {
+		if _, ok := g.fields[name.Value]; !ok {
+			g.types = append(g.types, name.Value)
+		}
+		g.fields[name.Value] = append(g.fields[name.Value], fields...)
+	}
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.ScalarDefinition:
+			add(def.Name, nil)
+		case *ast.ObjectDefinition:
+			add(def.Name, fieldDefinitionNames(def.Fields))
+		case *ast.TypeExtensionDefinition:
+			add(def.Definition.Name, fieldDefinitionNames(def.Definition.Fields))
+		case *ast.InterfaceDefinition:
+			add(def.Name, fieldDefinitionNames(def.Fields))
+		case *ast.InterfaceExtensionDefinition:
+			add(def.Definition.Name, fieldDefinitionNames(def.Definition.Fields))
+		case *ast.UnionDefinition:
+			add(def.Name, nil)
+		case *ast.EnumDefinition:
+			add(def.Name, enumValueNames(def.Values))
+		case *ast.EnumExtensionDefinition:
+			add(def.Definition.Name, enumValueNames(def.Definition.Values))
+		case *ast.InputObjectDefinition:
+			add(def.Name, inputValueNames(def.Fields))
+		case *ast.InputObjectExtensionDefinition:
+			add(def.Definition.Name, inputValueNames(def.Definition.Fields))
+		}
+	}
+}
+
+func fieldDefinitionNames(defs []*ast.FieldDefinition) []string {
+	names := []string{}
+	for _, def := range defs {
+		names = append(names, def.Name.Value)
+	}
+	return names
+}
+
+func enumValueNames(defs []*ast.EnumValueDefinition) []string {
+	names := []string{}
+	for _, def := range defs {
+		names = append(names, def.Name.Value)
+	}
+	return names
+}
+
+func inputValueNames(defs []*ast.InputValueDefinition) []string {
+	names := []string{}
+	for _, def := range defs {
+		names = append(names, def.Name.Value)
+	}
+	return names
+}
+
+func (g *generator) generateTypes() {
+	for _, name := range g.types {
+		switch ttype := g.schema.Type(name).(type) {
+		case *graphql.Object:
+			if g.roots[name] {
+				g.generateRootResolver(ttype)
+			} else {
+				g.generateObject(ttype)
+			}
+		case *graphql.Interface:
+			g.generateAbstract(name, ttype.Description())
+		case *graphql.Union:
+			g.generateAbstract(name, ttype.Description())
+		case *graphql.Enum:
+			g.generateEnum(ttype)
+		case *graphql.InputObject:
+			g.generateInputObject(ttype)
+		}
+	}
+}
+
+func (g *generator) generateObject(object *graphql.Object) {
+	name := goName(object.Name())
+	fields := object.Fields()
+	resolved := []*graphql.FieldDefinition{}
+
+	g.comment("", object.PrivateDescription, "")
+	g.printf("type %v struct {\n", name)
+	for _, fieldName := range g.fields[object.Name()] {
+		field := fields[fieldName]
+		if len(field.Args) > 0 {
+			resolved = append(resolved, field)
+			continue
+		}
+		g.comment("\t", field.Description, field.DeprecationReason)
+		g.printf("\t%v %v `json:%q`\n", goName(fieldName), g.goType(field.Type), fieldName)
+	}
+	g.printf("}\n\n")
+
+	for _, abstract := range g.abstractTypes(object) {
+		g.printf("func (*%v) Is%v() {}\n\n", name, goName(abstract))
+	}
+
+	if len(resolved) == 0 {
+		return
+	}
+	g.printf("// %vResolver resolves the fields of %v that take arguments.\n", name, object.Name())
+	g.printf("type %vResolver interface {\n", name)
+	for _, field := range resolved {
+		g.resolverMethod(object, field)
+	}
+	g.printf("}\n\n")
+	for _, field := range resolved {
+		g.generateArgs(object, field)
+	}
+}
+
+func (g *generator) generateRootResolver(object *graphql.Object) {
+	name := goName(object.Name())
+	fields := object.Fields()
+
+	g.printf("// %vResolver resolves the fields of the %v type.\n", name, object.Name())
+	g.printf("type %vResolver interface {\n", name)
+	for _, fieldName := range g.fields[object.Name()] {
+		g.resolverMethod(object, fields[fieldName])
+	}
+	g.printf("}\n\n")
+	for _, fieldName := range g.fields[object.Name()] {
+		g.generateArgs(object, fields[fieldName])
+	}
+}
+
+func (g *generator) resolverMethod(object *graphql.Object, field *graphql.FieldDefinition) {
+	g.imports["context"] = true
+	params := []string{"ctx context.Context"}
+	if !g.roots[object.Name()] {
+		params = append(params, "obj *"+goName(object.Name()))
+	}
+	if len(field.Args) > 0 {
+		params = append(params, "args "+argsName(object, field))
+	}
+	result := g.goType(field.Type)
+	if object == g.schema.SubscriptionType() {
+		result = "<-chan " + result
+	}
+	g.comment("\t", field.Description, field.DeprecationReason)
+	g.printf("\t%v(%v) (%v, error)\n", goName(field.Name), strings.Join(params, ", "), result)
+}
+
+func (g *generator) generateArgs(object *graphql.Object, field *graphql.FieldDefinition) {
+	if len(field.Args) == 0 {
+		return
+	}
+	g.printf("// %v holds the arguments of %v.%v.\n", argsName(object, field), object.Name(), field.Name)
+	g.printf("type %v struct {\n", argsName(object, field))
+	for _, arg := range field.Args {
+		g.comment("\t", arg.Description(), arg.DeprecationReason)
+		g.printf("\t%v %v `json:%q`\n", goName(arg.Name()), g.goType(arg.Type), arg.Name())
+	}
+	g.printf("}\n\n")
+}
+
+func argsName(object *graphql.Object, field *graphql.FieldDefinition) string {
+	return goName(object.Name()) + goName(field.Name) + "Args"
+}
+
+func (g *generator) generateAbstract(name, description string) {
+	g.comment("", description, "")
+	g.printf("type %v interface {\n\tIs%v()\n}\n\n", goName(name), goName(name))
+}
+
+func (g *generator) generateEnum(enum *graphql.Enum) {
+	name := goName(enum.Name())
+	values := map[string]*graphql.EnumValueDefinition{}
+	for _, value := range enum.Values() {
+		values[value.Name] = value
+	}
+
+	g.comment("", enum.Description(), "")
+	g.printf("type %v string\n\nconst (\n", name)
+	for _, valueName := range g.fields[enum.Name()] {
+		value := values[valueName]
+		g.comment("\t", value.Description, value.DeprecationReason)
+		g.printf("\t%v%v %v = %q\n", name, goName(valueName), name, valueName)
+	}
+	g.printf(")\n\n")
+}
+
+func (g *generator) generateInputObject(input *graphql.InputObject) {
+	fields := input.Fields()
+
+	g.comment("", input.Description(), "")
+	g.printf("type %v struct {\n", goName(input.Name()))
+	for _, fieldName := range g.fields[input.Name()] {
+		field := fields[fieldName]
+		g.comment("\t", field.Description(), field.DeprecationReason)
+		g.printf("\t%v %v `json:%q`\n", goName(fieldName), g.goType(field.Type), fieldName)
+	}
+	g.printf("}\n\n")
+}
+
+func (g *generator) generateSchema(sdl string) {
+	if strings.Contains(sdl, "`") {
+		g.printf("const schemaSDL = %v\n\n", strconv.Quote(sdl))
+	} else {
+		g.printf("const schemaSDL = `%v`\n\n", sdl)
+	}
+
+	g.printf("// Resolvers holds the resolvers of the schema.\ntype Resolvers struct {\n")
+	for _, name := range g.types {
+		if g.hasResolver(name) {
+			g.printf("\t%v %vResolver\n", goName(name), goName(name))
+		}
+	}
+	g.printf("}\n\n")
+
+	g.printf("// NewSchema builds the schema with its fields resolved by the resolvers.\n")
+	g.printf("func NewSchema(resolvers Resolvers) (graphql.Schema, error) {\n")
+	g.printf("\treturn graphql.BuildSchema(schemaSDL, graphql.BuildSchemaOptions{\n")
+	g.generateResolvers()
+	g.generateSubscribers()
+	g.generateResolveType()
+	g.generateEnumValues()
+	g.printf("\t})\n}\n")
+}
+
+// hasResolver reports whether a resolver interface is generated for the type.
+func (g *generator) hasResolver(name string) bool {
+	object, ok := g.schema.Type(name).(*graphql.Object)
+	if !ok {
+		return false
+	}
+	if g.roots[name] {
+		return true
+	}
+	for _, field := range object.Fields() {
+		if len(field.Args) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) generateResolvers() {
+	if !g.anyType(g.hasResolver) {
+		return
+	}
+	g.printf("\t\tResolvers: map[string]graphql.FieldResolveFn{\n")
+	for _, name := range g.types {
+		if !g.hasResolver(name) {
+			continue
+		}
+		object := g.schema.Type(name).(*graphql.Object)
+		fields := object.Fields()
+		for _, fieldName := range g.fields[name] {
+			field := fields[fieldName]
+			if object == g.schema.SubscriptionType() {
+				// the events sent by the subscriber are the values of the field
+				g.printf("\t\t\t%q: func(p graphql.ResolveParams) (any, error) {\n", name+"."+fieldName)
+				g.printf("\t\t\t\treturn p.Source, nil\n\t\t\t},\n")
+				continue
+			}
+			if !g.roots[name] && len(field.Args) == 0 {
+				continue
+			}
+			g.printf("\t\t\t%q: func(p graphql.ResolveParams) (any, error) {\n", name+"."+fieldName)
+			g.printf("\t\t\t\treturn resolvers.%v.%v(%v)\n\t\t\t},\n", goName(name), goName(fieldName), g.resolverArgs(object, field))
+		}
+	}
+	g.printf("\t\t},\n")
+}
+
+func (g *generator) generateSubscribers() {
+	subscription := g.schema.SubscriptionType()
+	if subscription == nil {
+		return
+	}
+	fields := subscription.Fields()
+	g.printf("\t\tSubscribers: map[string]graphql.FieldResolveFn{\n")
+	for _, fieldName := range g.fields[subscription.Name()] {
+		field := fields[fieldName]
+		g.printf("\t\t\t%q: func(p graphql.ResolveParams) (any, error) {\n", subscription.Name()+"."+fieldName)
+		g.printf("\t\t\t\tevents, err := resolvers.%v.%v(%v)\n", goName(subscription.Name()), goName(fieldName), g.resolverArgs(subscription, field))
+		g.printf(`				if err != nil {
+					return nil, err
+				}
+				results := make(chan any)
+				go func() {
+					defer close(results)
+					for event := range events {
+						select {
+						case results <- event:
+						case <-p.Context.Done():
+							return
+						}
+					}
+				}()
+				return results, nil
+			},
+`)
+	}
+	g.printf("\t\t},\n")
+}
+
+// resolverArgs prints the statements decoding the source and arguments of the
+// field and returns the arguments of its resolver method.
+func (g *generator) resolverArgs(object *graphql.Object, field *graphql.FieldDefinition) string {
+	args := []string{"p.Context"}
+	if !g.roots[object.Name()] {
+		g.imports["fmt"] = true
+		g.printf("\t\t\t\tobj, ok := p.Source.(*%v)\n", goName(object.Name()))
+		g.printf("\t\t\t\tif !ok {\n")
+		g.printf("\t\t\t\t\treturn nil, fmt.Errorf(\"expected source of type *%v but got: %%T\", p.Source)\n", goName(object.Name()))
+		g.printf("\t\t\t\t}\n")
+		args = append(args, "obj")
+	}
+	if len(field.Args) > 0 {
+		g.printf("\t\t\t\tvar args %v\n", argsName(object, field))
+		g.printf("\t\t\t\tif err := p.DecodeArgs(&args); err != nil {\n\t\t\t\t\treturn nil, err\n\t\t\t\t}\n")
+		args = append(args, "args")
+	}
+	return strings.Join(args, ", ")
+}
+
+func (g *generator) generateResolveType() {
+	isAbstract := func(name string) bool {
+		_, ok := g.abstractType(name)
+		return ok
+	}
+	if !g.anyType(isAbstract) {
+		return
+	}
+	g.printf("\t\tResolveType: map[string]graphql.ResolveTypeFn{\n")
+	for _, name := range g.types {
+		abstract, ok := g.abstractType(name)
+		if !ok {
+			continue
+		}
+		g.printf("\t\t\t%q: func(p graphql.ResolveTypeParams) *graphql.Object {\n", name)
+		g.printf("\t\t\t\tswitch p.Value.(type) {\n")
+		for _, possible := range g.types {
+			object, ok := g.schema.Type(possible).(*graphql.Object)
+			if !ok || !g.schema.IsPossibleType(abstract, object) {
+				continue
+			}
+			g.printf("\t\t\t\tcase *%v:\n", goName(possible))
+			g.printf("\t\t\t\t\treturn p.Info.Schema.Type(%q).(*graphql.Object)\n", possible)
+		}
+		g.printf("\t\t\t\t}\n\t\t\t\treturn nil\n\t\t\t},\n")
+	}
+	g.printf("\t\t},\n")
+}
+
+func (g *generator) generateEnumValues() {
+	isEnum := func(name string) bool {
+		_, ok := g.schema.Type(name).(*graphql.Enum)
+		return ok
+	}
+	if !g.anyType(isEnum) {
+		return
+	}
+	g.printf("\t\tEnumValues: map[string]any{\n")
+	for _, name := range g.types {
+		if !isEnum(name) {
+			continue
+		}
+		for _, valueName := range g.fields[name] {
+			g.printf("\t\t\t%q: %v%v,\n", name+"."+valueName, goName(name), goName(valueName))
+		}
+	}
+	g.printf("\t\t},\n")
+}
+
+func (g *generator) anyType(fn func(name string) bool) bool {
+	for _, name := range g.types {
+		if fn(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// abstractTypes returns the names of the interfaces and unions the object
+// belongs to.
+func (g *generator) abstractTypes(object *graphql.Object) []string {
+	names := []string{}
+	for _, name := range g.types {
+		abstract, ok := g.abstractType(name)
+		if ok && g.schema.IsPossibleType(abstract, object) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func (g *generator) abstractType(name string) (graphql.Abstract, bool) {
+	switch ttype := g.schema.Type(name).(type) {
+	case *graphql.Interface:
+		return ttype, true
+	case *graphql.Union:
+		return ttype, true
+	}
+	return nil, false
+}
+
+// goType returns the Go type of values of the graphql type. Nullable scalars,
+// enums and input objects are pointers, objects are always pointers.
+func (g *generator) goType(ttype graphql.Type) string {
+	if nonNull, ok := ttype.(*graphql.NonNull); ok {
+		return g.goNonNullType(nonNull.OfType)
+	}
+	goType := g.goNonNullType(ttype)
+	switch ttype.(type) {
+	case *graphql.List, *graphql.Object, *graphql.Interface, *graphql.Union:
+		return goType
+	}
+	if goType == "any" {
+		return goType
+	}
+	return "*" + goType
+}
+
+func (g *generator) goNonNullType(ttype graphql.Type) string {
+	switch ttype := ttype.(type) {
+	case *graphql.List:
+		return "[]" + g.goType(ttype.OfType)
+	case *graphql.Object:
+		return "*" + goName(ttype.Name())
+	case *graphql.Scalar:
+		switch ttype.Name() {
+		case graphql.Int.Name():
+			return "int"
+		case graphql.Float.Name():
+			return "float64"
+		case graphql.String.Name(), graphql.ID.Name():
+			return "string"
+		case graphql.Boolean.Name():
+			return "bool"
+		case graphql.DateTime.Name():
+			g.imports["time"] = true
+			return "time.Time"
+		}
+		return "any"
+	}
+	return goName(ttype.Name())
+}
+
+// comment prints the description and deprecation reason of a definition as
+// doc comment.
+func (g *generator) comment(indent, description, deprecationReason string) {
+	if description != "" {
+		for _, line := range strings.Split(description, "\n") {
+			g.printf("%v// %v\n", indent, line)
+		}
+	}
+	if deprecationReason != "" {
+		if description != "" {
+			g.printf("%v//\n", indent)
+		}
+		g.printf("%v// Deprecated: %v\n", indent, deprecationReason)
+	}
+}
+
+// goName returns the exported Go name of a graphql name, e.g. created_at and
+// createdAt become CreatedAt and IN_PROGRESS becomes InProgress.
+func goName(name string) string {
+	b := strings.Builder{}
+	for _, word := range splitWords(name) {
+		upper := strings.ToUpper(word)
+		if initialisms[upper] {
+			b.WriteString(upper)
+			continue
+		}
+		if upper == word {
+			word = strings.ToLower(word)
+		}
+		b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+	}
+	return b.String()
+}
+
+// splitWords splits a graphql name at underscores and lower to upper case
+// transitions.
+func splitWords(name string) []string {
+	words := []string{}
+	for _, part := range strings.Split(name, "_") {
+		start := 0
+		for i := 1; i < len(part); i++ {
+			if unicode.IsLower(rune(part[i-1])) && unicode.IsUpper(rune(part[i])) {
+				words = append(words, part[start:i])
+				start = i
+			}
+		}
+		if start < len(part) {
+			words = append(words, part[start:])
+		}
+	}
+	return words
+}
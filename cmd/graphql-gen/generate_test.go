@@ -0,0 +1,103 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerate_MatchesExample(t *testing.T) {
+	sdl, err := os.ReadFile("../../examples/codegen/schema.graphql")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected, err := os.ReadFile("../../examples/codegen/schema_gen.go")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	code, err := generate("main", []schemaFile{{Name: "schema.graphql", Body: sdl}})
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(code), "run go generate in examples/codegen")
+}
+
+func TestGenerate_MergesFilesAndSubscriptions(t *testing.T) {
+	code, err := generate("chat", []schemaFile{
+		{Name: "chat.graphql", Body: []byte(`
+			type Message {
+				id: ID!
+				"Uses ` + "`markdown`" + `."
+				body: String!
+			}
+
+			type Query {
+				messages: [Message]
+			}
+
+			type Subscription {
+				messageAdded(room_id: ID!): Message!
+			}
+		`)},
+		{Name: "extensions.graphql", Body: []byte(`
+			extend type Message {
+				sentAt: DateTime
+				meta: JSON
+			}
+
+			scalar JSON
+			scalar DateTime
+		`)},
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Contains(t, string(code), "package chat\n")
+	assert.Contains(t, string(code), `type Message struct {
+	ID string `+"`json:\"id\"`"+`
+	// Uses `+"`markdown`"+`.
+	Body   string     `+"`json:\"body\"`"+`
+	SentAt *time.Time `+"`json:\"sentAt\"`"+`
+	Meta   any        `+"`json:\"meta\"`"+`
+}`)
+	assert.Contains(t, string(code), `type QueryResolver interface {
+	Messages(ctx context.Context) ([]*Message, error)
+}`)
+	assert.Contains(t, string(code), `type SubscriptionResolver interface {
+	MessageAdded(ctx context.Context, args SubscriptionMessageAddedArgs) (<-chan *Message, error)
+}`)
+	assert.Contains(t, string(code), `type SubscriptionMessageAddedArgs struct {
+	RoomID string `+"`json:\"room_id\"`"+`
+}`)
+	assert.Contains(t, string(code), `const schemaSDL = "\n\t\t\ttype Message {`)
+	assert.Contains(t, string(code), `		Subscribers: map[string]graphql.FieldResolveFn{
+			"Subscription.messageAdded": func(p graphql.ResolveParams) (any, error) {
+				var args SubscriptionMessageAddedArgs
+				if err := p.DecodeArgs(&args); err != nil {
+					return nil, err
+				}
+				events, err := resolvers.Subscription.MessageAdded(p.Context, args)`)
+}
+
+func TestGenerate_RejectsInvalidSchemas(t *testing.T) {
+	_, err := generate("main", []schemaFile{{Name: "schema.graphql", Body: []byte(`type Query { user: User }`)}})
+	assert.EqualError(t, err, `Unknown type "User".`)
+
+	_, err = generate("main", []schemaFile{{Name: "schema.graphql", Body: []byte(`type Query {`)}})
+	assert.Error(t, err)
+}
+
+func TestGoName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"createdAt":   "CreatedAt",
+		"created_at":  "CreatedAt",
+		"IN_PROGRESS": "InProgress",
+		"userId":      "UserID",
+		"homepageUrl": "HomepageURL",
+		"HTTPServer":  "HTTPServer",
+		"_id":         "ID",
+	} {
+		assert.Equal(t, expected, goName(name), name)
+	}
+}
@@ -0,0 +1,64 @@
+// Command graphql-gen generates Go code for schema-first development from
+// GraphQL schema files.
+//
+// For the types of the schema it generates
+//
+//   - a struct per object and input object type,
+//   - a string type with constants per enum,
+//   - a Go interface per interface and union type, implemented by its possible types,
+//   - a resolver interface per root operation type with a method per field, and per
+//     other object type with a method per field taking arguments,
+//   - a Resolvers struct holding the resolver interfaces and a NewSchema function
+//     that builds the graphql.Schema with its fields wired to the resolvers.
+//
+// Usage:
+//
+//	graphql-gen [-package name] [-out file] schema.graphql [more.graphql ...]
+//
+// e.g. in a go:generate directive:
+//
+//	//go:generate go run github.com/fraym/graphql-go/cmd/graphql-gen -package models -out schema_gen.go schema.graphql
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+func main() {
+	pkg := flag.String("package", "main", "package name of the generated code")
+	out := flag.String("out", "schema_gen.go", "file the generated code is written to")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: graphql-gen [-package name] [-out file] schema.graphql [more.graphql ...]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	files := []schemaFile{}
+	for _, name := range flag.Args() {
+		body, err := os.ReadFile(name)
+		if err != nil {
+			exit(err)
+		}
+		files = append(files, schemaFile{Name: name, Body: body})
+	}
+
+	code, err := generate(*pkg, files)
+	if err != nil {
+		exit(err)
+	}
+	if err := os.WriteFile(*out, code, 0o644); err != nil {
+		exit(err)
+	}
+}
+
+func exit(err error) {
+	fmt.Fprintf(os.Stderr, "graphql-gen: %v\n", err)
+	os.Exit(1)
+}
@@ -0,0 +1,273 @@
+package graphql_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/fraym/graphql-go"
+	"github.com/stretchr/testify/assert"
+)
+
+// concurrencyTracker records the maximum number of resolvers running at the
+// same time.
+type concurrencyTracker struct {
+	mu     sync.Mutex
+	active int
+	max    int
+	order  []string
+}
+
+func (c *concurrencyTracker) resolve(value any) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (any, error) {
+		c.mu.Lock()
+		c.active++
+		c.max = max(c.max, c.active)
+		c.order = append(c.order, p.Info.FieldName)
+		c.mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		c.mu.Lock()
+		c.active--
+		c.mu.Unlock()
+		return value, nil
+	}
+}
+
+func newConcurrencyTestSchema(t *testing.T, tracker *concurrencyTracker) graphql.Schema {
+	itemType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Item",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"a": &graphql.Field{Type: graphql.String, Resolve: tracker.resolve("a")},
+				"b": &graphql.Field{Type: graphql.String, Resolve: tracker.resolve("b")},
+				"c": &graphql.Field{Type: graphql.String, Resolve: tracker.resolve("c")},
+			}
+		}),
+	})
+	fields := graphql.Fields{}
+	for i := 0; i < 6; i++ {
+		fields[fmt.Sprintf("item%d", i)] = &graphql.Field{Type: itemType, Resolve: tracker.resolve(map[string]any{})}
+	}
+	fields["items"] = &graphql.Field{
+		Type:    graphql.NewList(itemType),
+		Resolve: tracker.resolve([]any{map[string]any{}, map[string]any{}, map[string]any{}}),
+	}
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{Name: "Query", Fields: fields}),
+		Mutation: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Mutation",
+			Fields: graphql.Fields{
+				"first":  &graphql.Field{Type: itemType, Resolve: tracker.resolve(map[string]any{})},
+				"second": &graphql.Field{Type: itemType, Resolve: tracker.resolve(map[string]any{})},
+				"third":  &graphql.Field{Type: itemType, Resolve: tracker.resolve(map[string]any{})},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return schema
+}
+
+func TestExecute_ResolvesSiblingFieldsConcurrently(t *testing.T) {
+	started := sync.WaitGroup{}
+	started.Add(3)
+	resolve := func(p graphql.ResolveParams) (any, error) {
+		started.Done()
+		done := make(chan struct{})
+		go func() {
+			started.Wait()
+			close(done)
+		}()
+		select {
+		case <-done:
+			return p.Info.FieldName, nil
+		case <-time.After(time.Second):
+			return nil, errors.New("siblings were not resolved concurrently")
+		}
+	}
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"a": &graphql.Field{Type: graphql.String, Resolve: resolve},
+				"b": &graphql.Field{Type: graphql.String, Resolve: resolve},
+				"c": &graphql.Field{Type: graphql.String, Resolve: resolve},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  `{ c b a }`,
+		MaxConcurrency: 3,
+		OrderedData:    true,
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, []string{"c", "b", "a"}, result.Data.(*graphql.OrderedMap).Keys())
+	assert.Equal(t, map[string]any{"a": "a", "b": "b", "c": "c"}, result.Data.(*graphql.OrderedMap).Map())
+}
+
+func TestExecute_LimitsConcurrentlyResolvedFields(t *testing.T) {
+	tracker := &concurrencyTracker{}
+	schema := newConcurrencyTestSchema(t, tracker)
+	query := `{
+		item0 { a b c } item1 { a b c } item2 { a b c }
+		item3 { a b c } item4 { a b c } item5 { a b c }
+		items { c b a }
+	}`
+
+	serial := graphql.Do(graphql.Params{Schema: schema, RequestString: query, OrderedData: true})
+	assert.Equal(t, 1, tracker.max)
+
+	tracker.max = 0
+	concurrent := graphql.Do(graphql.Params{Schema: schema, RequestString: query, MaxConcurrency: 3, OrderedData: true})
+	assert.Empty(t, concurrent.Errors)
+	assert.Equal(t, 3, tracker.max)
+	assert.Equal(t, serial, concurrent)
+
+	b, err := json.Marshal(concurrent)
+	assert.NoError(t, err)
+	assert.Contains(t, string(b), `"items":[{"c":"c","b":"b","a":"a"},{"c":"c","b":"b","a":"a"},{"c":"c","b":"b","a":"a"}]`)
+}
+
+func TestExecute_ResolvesMutationFieldsSerially(t *testing.T) {
+	tracker := &concurrencyTracker{}
+	schema := newConcurrencyTestSchema(t, tracker)
+
+	result := graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  `mutation { third { a b } first { a b } second { a b } }`,
+		MaxConcurrency: 4,
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, 1, tracker.max)
+	assert.Equal(t, []string{"third", "a", "b", "first", "a", "b", "second", "a", "b"}, tracker.order)
+}
+
+func TestExecute_NullsParentsOfConcurrentlyResolvedNonNullFields(t *testing.T) {
+	ownerType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Owner",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					return nil, errors.New("name is unknown")
+				},
+			},
+			"age": &graphql.Field{Type: graphql.Int},
+		},
+	})
+	petType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Pet",
+		Fields: graphql.Fields{
+			"name":  &graphql.Field{Type: graphql.String},
+			"owner": &graphql.Field{Type: graphql.NewNonNull(ownerType)},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"pet":   &graphql.Field{Type: petType},
+				"other": &graphql.Field{Type: graphql.String},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, maxConcurrency := range []int{0, 4} {
+		t.Run(fmt.Sprintf("MaxConcurrency %d", maxConcurrency), func(t *testing.T) {
+			result := graphql.Do(graphql.Params{
+				Schema:        schema,
+				RequestString: `{ pet { name owner { age name } } other }`,
+				RootObject: map[string]any{
+					"pet":   map[string]any{"name": "Odie", "owner": map[string]any{"age": 42}},
+					"other": "other",
+				},
+				MaxConcurrency: maxConcurrency,
+			})
+			assert.Equal(t, map[string]any{"pet": nil, "other": "other"}, result.Data)
+			if assert.Len(t, result.Errors, 1) {
+				assert.Equal(t, "name is unknown", result.Errors[0].Message)
+				assert.Equal(t, []any{"pet", "owner", "name"}, result.Errors[0].Path)
+			}
+		})
+	}
+}
+
+type fieldPathKey struct{}
+
+func TestExecute_ScopesExtensionContextsToFields(t *testing.T) {
+	// calls counts the calls of the extension without synchronization, the
+	// executor serializes them
+	calls := map[string]int{}
+	ext := newtestExt("pathExt")
+	ext.resolveFieldDidStartFn = func(ctx context.Context, i *graphql.ResolveInfo) (context.Context, graphql.ResolveFieldFinishFunc) {
+		calls[i.FieldName]++
+		path, _ := ctx.Value(fieldPathKey{}).(string)
+		return context.WithValue(ctx, fieldPathKey{}, path+"/"+i.FieldName), func(any, error) {
+			calls[i.FieldName+" finished"]++
+		}
+	}
+
+	resolvePath := func(p graphql.ResolveParams) (any, error) {
+		return p.Context.Value(fieldPathKey{}), nil
+	}
+	resolveNode := func(p graphql.ResolveParams) (any, error) {
+		return map[string]any{}, nil
+	}
+	nodeType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Node",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"path":  &graphql.Field{Type: graphql.String, Resolve: resolvePath},
+				"left":  &graphql.Field{Type: graphql.String, Resolve: resolvePath},
+				"right": &graphql.Field{Type: graphql.String, Resolve: resolvePath},
+			}
+		}),
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"first":  &graphql.Field{Type: nodeType, Resolve: resolveNode},
+				"second": &graphql.Field{Type: nodeType, Resolve: resolveNode},
+			},
+		}),
+		Extensions: []graphql.Extension{ext},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, maxConcurrency := range []int{0, 4} {
+		t.Run(fmt.Sprintf("MaxConcurrency %d", maxConcurrency), func(t *testing.T) {
+			clear(calls)
+			result := graphql.Do(graphql.Params{
+				Schema:         schema,
+				RequestString:  `{ first { path left right } second { path left right } }`,
+				Context:        context.Background(),
+				MaxConcurrency: maxConcurrency,
+			})
+			assert.Empty(t, result.Errors)
+			assert.Equal(t, map[string]any{
+				"first":  map[string]any{"path": "/first/path", "left": "/first/left", "right": "/first/right"},
+				"second": map[string]any{"path": "/second/path", "left": "/second/left", "right": "/second/right"},
+			}, result.Data)
+			assert.Equal(t, 2, calls["path"])
+			assert.Equal(t, 2, calls["path finished"])
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// Package dataloader provides Loaders, which collect the keys requested by
+// resolvers and load them in batches, collapsing the N+1 queries of nested
+// lists into one query per level of the result.
+//
+// Load returns a thunk, which resolvers return in place of the value:
+//
+//	"author": &graphql.Field{
+//	  Type: userType,
+//	  Resolve: func(p graphql.ResolveParams) (any, error) {
+//	    loader := p.Context.Value(loadersKey{}).(*loaders).users
+//	    return loader.Load(p.Context, p.Source.(*Post).AuthorID), nil
+//	  },
+//	},
+//
+// Loaders schedule themselves with graphql.ScheduleFlush, so the executor
+// loads the keys collected by the resolvers of each breadth-first level of the
+// result before it calls the thunks of that level. Outside of executions, the
+// first thunk called loads the keys collected so far.
+//
+// Loaders cache the values they loaded, so they are meant to be created for
+// every request, for example by the HTTP handler putting them into the
+// context of the request.
+package dataloader
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/fraym/graphql-go"
+)
+
+// Result is the result of loading a key.
+type Result[V any] struct {
+	Value V
+	Error error
+}
+
+// BatchFunc loads the values of keys. It returns a Result for every key, in
+// the order of the keys.
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) []Result[V]
+
+// Thunk returns a value once it is loaded. Thunks are resolved by the
+// executor like resolvers returning func() (any, error).
+type Thunk[V any] func() (V, error)
+
+// LoaderConfig configures a Loader created with NewLoader.
+type LoaderConfig[K comparable, V any] struct {
+	// Batch loads the collected keys.
+	Batch BatchFunc[K, V]
+
+	// MaxBatchSize is the maximum number of keys passed to Batch at once.
+	// Batches are not limited if it is 0.
+	MaxBatchSize int
+}
+
+// Loader loads values of type V by keys of type K in batches. It is safe for
+// concurrent use.
+type Loader[K comparable, V any] struct {
+	batchFn      BatchFunc[K, V]
+	maxBatchSize int
+
+	mu sync.Mutex
+	// cache holds the loaded and pending results by their keys.
+	cache map[K]*result[K, V]
+	// pending holds the batches which haven't been loaded yet, all but the
+	// last of them being full.
+	pending []*batch[K, V]
+}
+
+type result[K comparable, V any] struct {
+	batch *batch[K, V]
+	value V
+	err   error
+}
+
+type batch[K comparable, V any] struct {
+	ctx     context.Context
+	keys    []K
+	results []*result[K, V]
+	once    sync.Once
+}
+
+// NewLoader creates a Loader with an empty cache.
+func NewLoader[K comparable, V any](config LoaderConfig[K, V]) *Loader[K, V] {
+	return &Loader[K, V]{
+		batchFn:      config.Batch,
+		maxBatchSize: config.MaxBatchSize,
+		cache:        map[K]*result[K, V]{},
+	}
+}
+
+// Load collects key and returns a thunk for its value. The key is loaded with
+// the other keys collected until the executor flushes the loader or a thunk
+// of the batch is called, with the context of the first key of the batch.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) Thunk[V] {
+	l.mu.Lock()
+	r, ok := l.cache[key]
+	if !ok {
+		r = l.add(ctx, key)
+	}
+	l.mu.Unlock()
+
+	return func() (V, error) {
+		l.load(r.batch)
+		return r.value, r.err
+	}
+}
+
+// LoadMany collects keys and returns a thunk for their values, which fails
+// with the first error of the keys.
+func (l *Loader[K, V]) LoadMany(ctx context.Context, keys []K) Thunk[[]V] {
+	thunks := make([]Thunk[V], len(keys))
+	for i, key := range keys {
+		thunks[i] = l.Load(ctx, key)
+	}
+
+	return func() ([]V, error) {
+		values := make([]V, len(thunks))
+		for i, thunk := range thunks {
+			value, err := thunk()
+			if err != nil {
+				return nil, err
+			}
+			values[i] = value
+		}
+		return values, nil
+	}
+}
+
+// Prime adds the value of key to the cache, unless the key was loaded
+// already.
+func (l *Loader[K, V]) Prime(key K, value V) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if _, ok := l.cache[key]; !ok {
+		l.cache[key] = &result[K, V]{value: value}
+	}
+}
+
+// Clear removes key from the cache, so it is loaded again by the next call
+// of Load. Errors are cached like values, so keys are cleared to retry them.
+func (l *Loader[K, V]) Clear(key K) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	delete(l.cache, key)
+}
+
+// Flush loads the keys collected so far. It implements graphql.Batcher.
+func (l *Loader[K, V]) Flush() {
+	l.mu.Lock()
+	pending := slices.Clone(l.pending)
+	l.mu.Unlock()
+	for _, b := range pending {
+		l.load(b)
+	}
+}
+
+// add adds the key to the pending batches. The caller must hold l.mu.
+func (l *Loader[K, V]) add(ctx context.Context, key K) *result[K, V] {
+	var b *batch[K, V]
+	if len(l.pending) > 0 {
+		b = l.pending[len(l.pending)-1]
+	}
+	if b == nil || l.maxBatchSize > 0 && len(b.keys) >= l.maxBatchSize {
+		b = &batch[K, V]{ctx: ctx}
+		l.pending = append(l.pending, b)
+		graphql.ScheduleFlush(ctx, l)
+	}
+	r := &result[K, V]{batch: b}
+	b.keys = append(b.keys, key)
+	b.results = append(b.results, r)
+	l.cache[key] = r
+	return r
+}
+
+// load loads the keys of the batch unless they were loaded already, and
+// waits until they are loaded otherwise.
+func (l *Loader[K, V]) load(b *batch[K, V]) {
+	if b == nil {
+		return
+	}
+	b.once.Do(func() {
+		l.mu.Lock()
+		for i, pending := range l.pending {
+			if pending == b {
+				l.pending = append(l.pending[:i], l.pending[i+1:]...)
+				break
+			}
+		}
+		l.mu.Unlock()
+
+		results, err := l.callBatchFn(b)
+		for i, r := range b.results {
+			if err != nil {
+				r.err = err
+				continue
+			}
+			r.value, r.err = results[i].Value, results[i].Error
+		}
+	})
+}
+
+func (l *Loader[K, V]) callBatchFn(b *batch[K, V]) (results []Result[V], err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("dataloader: panic while loading batch: %v", r)
+		}
+	}()
+
+	results = l.batchFn(b.ctx, b.keys)
+	if len(results) != len(b.keys) {
+		return nil, fmt.Errorf("dataloader: batch function returned %d results for %d keys", len(results), len(b.keys))
+	}
+	return results, nil
+}
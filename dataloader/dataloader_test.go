@@ -0,0 +1,307 @@
+package dataloader_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/dataloader"
+	"github.com/stretchr/testify/assert"
+)
+
+// batchRecorder records the keys of the batches loaded by a Loader.
+type batchRecorder struct {
+	mu      sync.Mutex
+	batches [][]int
+}
+
+func (r *batchRecorder) loader(maxBatchSize int) *dataloader.Loader[int, string] {
+	return dataloader.NewLoader(dataloader.LoaderConfig[int, string]{
+		Batch: func(ctx context.Context, keys []int) []dataloader.Result[string] {
+			r.mu.Lock()
+			r.batches = append(r.batches, keys)
+			r.mu.Unlock()
+
+			results := make([]dataloader.Result[string], len(keys))
+			for i, key := range keys {
+				if key < 0 {
+					results[i].Error = fmt.Errorf("invalid key %d", key)
+					continue
+				}
+				results[i].Value = fmt.Sprintf("value %d", key)
+			}
+			return results
+		},
+		MaxBatchSize: maxBatchSize,
+	})
+}
+
+func TestLoader_LoadsKeysInBatches(t *testing.T) {
+	recorder := &batchRecorder{}
+	loader := recorder.loader(0)
+	ctx := context.Background()
+
+	first := loader.Load(ctx, 1)
+	second := loader.Load(ctx, 2)
+	again := loader.Load(ctx, 1)
+	invalid := loader.Load(ctx, -1)
+	assert.Empty(t, recorder.batches)
+
+	value, err := second()
+	assert.NoError(t, err)
+	assert.Equal(t, "value 2", value)
+	value, err = first()
+	assert.NoError(t, err)
+	assert.Equal(t, "value 1", value)
+	value, err = again()
+	assert.NoError(t, err)
+	assert.Equal(t, "value 1", value)
+	_, err = invalid()
+	assert.EqualError(t, err, "invalid key -1")
+
+	value, err = loader.Load(ctx, 2)()
+	assert.NoError(t, err)
+	assert.Equal(t, "value 2", value)
+	assert.Equal(t, [][]int{{1, 2, -1}}, recorder.batches)
+}
+
+func TestLoader_LimitsBatchSize(t *testing.T) {
+	recorder := &batchRecorder{}
+	loader := recorder.loader(2)
+
+	values, err := loader.LoadMany(context.Background(), []int{1, 2, 3, 4, 5})()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"value 1", "value 2", "value 3", "value 4", "value 5"}, values)
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, recorder.batches)
+
+	_, err = loader.LoadMany(context.Background(), []int{6, -7})()
+	assert.EqualError(t, err, "invalid key -7")
+}
+
+func TestLoader_Flush(t *testing.T) {
+	recorder := &batchRecorder{}
+	loader := recorder.loader(2)
+
+	thunk := loader.Load(context.Background(), 1)
+	loader.Load(context.Background(), 2)
+	loader.Load(context.Background(), 3)
+	loader.Flush()
+	assert.Equal(t, [][]int{{1, 2}, {3}}, recorder.batches)
+
+	loader.Flush()
+	value, err := thunk()
+	assert.NoError(t, err)
+	assert.Equal(t, "value 1", value)
+	assert.Len(t, recorder.batches, 2)
+}
+
+func TestLoader_PrimeAndClear(t *testing.T) {
+	recorder := &batchRecorder{}
+	loader := recorder.loader(0)
+	ctx := context.Background()
+
+	loader.Prime(1, "primed")
+	value, err := loader.Load(ctx, 1)()
+	assert.NoError(t, err)
+	assert.Equal(t, "primed", value)
+	assert.Empty(t, recorder.batches)
+
+	loader.Clear(1)
+	loader.Prime(2, "primed")
+	value, err = loader.Load(ctx, 1)()
+	assert.NoError(t, err)
+	assert.Equal(t, "value 1", value)
+	loader.Prime(1, "primed")
+	value, err = loader.Load(ctx, 1)()
+	assert.NoError(t, err)
+	assert.Equal(t, "value 1", value)
+	assert.Equal(t, [][]int{{1}}, recorder.batches)
+}
+
+func TestLoader_FailsKeysOfInvalidBatches(t *testing.T) {
+	tests := map[string]struct {
+		batchFn dataloader.BatchFunc[int, string]
+		message string
+	}{
+		"missing results": {
+			batchFn: func(ctx context.Context, keys []int) []dataloader.Result[string] {
+				return []dataloader.Result[string]{{Value: "value"}}
+			},
+			message: "dataloader: batch function returned 1 results for 2 keys",
+		},
+		"panic": {
+			batchFn: func(ctx context.Context, keys []int) []dataloader.Result[string] {
+				panic(errors.New("database is down"))
+			},
+			message: "dataloader: panic while loading batch: database is down",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			loader := dataloader.NewLoader(dataloader.LoaderConfig[int, string]{Batch: test.batchFn})
+			first := loader.Load(context.Background(), 1)
+			second := loader.Load(context.Background(), 2)
+			_, err := first()
+			assert.EqualError(t, err, test.message)
+			_, err = second()
+			assert.EqualError(t, err, test.message)
+		})
+	}
+}
+
+func TestLoader_IsSafeForConcurrentUse(t *testing.T) {
+	recorder := &batchRecorder{}
+	loader := recorder.loader(3)
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			value, err := loader.Load(context.Background(), i%10)()
+			assert.NoError(t, err)
+			assert.Equal(t, fmt.Sprintf("value %d", i%10), value)
+		}()
+	}
+	wg.Wait()
+
+	loaded := map[int]int{}
+	for _, batch := range recorder.batches {
+		assert.LessOrEqual(t, len(batch), 3)
+		for _, key := range batch {
+			loaded[key]++
+		}
+	}
+	assert.Len(t, loaded, 10)
+	for key, count := range loaded {
+		assert.Equal(t, 1, count, "key %d was loaded %d times", key, count)
+	}
+}
+
+type loaderKey struct{}
+
+type testUser struct {
+	Name      string
+	FriendIDs []int
+}
+
+// newUserLoader returns a loader of users 1 to 6, each being friends with the
+// next two users, recording its batches with recorder.
+func newUserLoader(recorder *batchRecorder) *dataloader.Loader[int, *testUser] {
+	return dataloader.NewLoader(dataloader.LoaderConfig[int, *testUser]{
+		Batch: func(ctx context.Context, keys []int) []dataloader.Result[*testUser] {
+			// keys collected by concurrently resolved fields are in any order
+			sorted := slices.Clone(keys)
+			slices.Sort(sorted)
+			recorder.mu.Lock()
+			recorder.batches = append(recorder.batches, sorted)
+			recorder.mu.Unlock()
+
+			results := make([]dataloader.Result[*testUser], len(keys))
+			for i, key := range keys {
+				results[i].Value = &testUser{
+					Name:      fmt.Sprintf("user %d", key),
+					FriendIDs: []int{key%6 + 1, (key+1)%6 + 1},
+				}
+			}
+			return results
+		},
+	})
+}
+
+func newUserSchema(t *testing.T) graphql.Schema {
+	loadUser := func(p graphql.ResolveParams) (any, error) {
+		return p.Context.Value(loaderKey{}).(*dataloader.Loader[int, *testUser]).Load(p.Context, int(p.Args["id"].(int64))), nil
+	}
+	idArgs := graphql.FieldConfigArgument{
+		&graphql.ArgumentConfig{Name: "id", Type: graphql.NewNonNull(graphql.Int)},
+	}
+
+	var userType *graphql.Object
+	userType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"name": &graphql.Field{Type: graphql.String},
+				"friends": &graphql.Field{
+					Type: graphql.NewList(userType),
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						loader := p.Context.Value(loaderKey{}).(*dataloader.Loader[int, *testUser])
+						friends := []any{}
+						for _, id := range p.Source.(*testUser).FriendIDs {
+							friends = append(friends, loader.Load(p.Context, id))
+						}
+						return friends, nil
+					},
+				},
+			}
+		}),
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"user": &graphql.Field{Type: userType, Args: idArgs, Resolve: loadUser},
+			},
+		}),
+		Mutation: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Mutation",
+			Fields: graphql.Fields{
+				"touchUser": &graphql.Field{Type: userType, Args: idArgs, Resolve: loadUser},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return schema
+}
+
+func TestLoader_IsFlushedOncePerLevel(t *testing.T) {
+	schema := newUserSchema(t)
+	for _, maxConcurrency := range []int{0, 4} {
+		t.Run(fmt.Sprintf("MaxConcurrency %d", maxConcurrency), func(t *testing.T) {
+			recorder := &batchRecorder{}
+			result := graphql.Do(graphql.Params{
+				Schema:         schema,
+				RequestString:  `{ user(id: 1) { name friends { name friends { name } } } }`,
+				Context:        context.WithValue(context.Background(), loaderKey{}, newUserLoader(recorder)),
+				MaxConcurrency: maxConcurrency,
+			})
+			assert.Empty(t, result.Errors)
+			assert.Equal(t, map[string]any{
+				"user": map[string]any{
+					"name": "user 1",
+					"friends": []any{
+						map[string]any{"name": "user 2", "friends": []any{map[string]any{"name": "user 3"}, map[string]any{"name": "user 4"}}},
+						map[string]any{"name": "user 3", "friends": []any{map[string]any{"name": "user 4"}, map[string]any{"name": "user 5"}}},
+					},
+				},
+			}, result.Data)
+			assert.Equal(t, [][]int{{1}, {2, 3}, {4, 5}}, recorder.batches)
+		})
+	}
+}
+
+func TestLoader_IsLoadedByThunksOfMutations(t *testing.T) {
+	recorder := &batchRecorder{}
+	result := graphql.Do(graphql.Params{
+		Schema:        newUserSchema(t),
+		RequestString: `mutation { touchUser(id: 1) { friends { friends { name } } } }`,
+		Context:       context.WithValue(context.Background(), loaderKey{}, newUserLoader(recorder)),
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{
+		"touchUser": map[string]any{
+			"friends": []any{
+				map[string]any{"friends": []any{map[string]any{"name": "user 3"}, map[string]any{"name": "user 4"}}},
+				map[string]any{"friends": []any{map[string]any{"name": "user 4"}, map[string]any{"name": "user 5"}}},
+			},
+		},
+	}, result.Data)
+	assert.Equal(t, [][]int{{1}, {2, 3}, {4}, {5}}, recorder.batches)
+}
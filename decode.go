@@ -0,0 +1,397 @@
+package graphql
+
+import (
+	"encoding"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// DecodeError is returned when an input value cannot be decoded into a Go value.
+type DecodeError struct {
+	// Path is the path of the value, starting with the argument name and
+	// followed by input field names and list indices.
+	Path    []any
+	Message string
+}
+
+func (e *DecodeError) Error() string {
+	if len(e.Path) == 0 {
+		return fmt.Sprintf("Cannot decode value: %v", e.Message)
+	}
+	path := make([]string, 0, len(e.Path))
+	for _, key := range e.Path {
+		path = append(path, fmt.Sprint(key))
+	}
+	return fmt.Sprintf(`Cannot decode "%v": %v`, strings.Join(path, "."), e.Message)
+}
+
+// Optional holds a decoded argument or input field value together with whether
+// it was provided, which tells absent values from those explicitly set to null,
+// e.g. to leave a value unchanged rather than clearing it.
+type Optional[T any] struct {
+	Value T
+
+	// Set reports whether the value was provided or has a default value.
+	Set bool
+
+	// Null reports whether the value was provided as null.
+	Null bool
+}
+
+// optionalValue is implemented by pointers to Optional values.
+type optionalValue interface {
+	decodeOptional(value any, ttype Input, path []any) error
+}
+
+func (o *Optional[T]) decodeOptional(value any, ttype Input, path []any) error {
+	o.Set = true
+	o.Null = value == nil
+	return decodeValue(value, ttype, reflect.ValueOf(&o.Value).Elem(), path)
+}
+
+// DecodeArgs decodes the arguments of the field into the struct v points to.
+// Arguments are matched to the exported fields of the struct like input object
+// fields are, see DecodeInput.
+func (p ResolveParams) DecodeArgs(v any) error {
+	argTypes := map[string]Input{}
+	if p.Info.FieldDefinition != nil {
+		for _, arg := range p.Info.FieldDefinition.Args {
+			argTypes[arg.Name()] = arg.Type
+		}
+	}
+	return decodeArgs(p.Args, argTypes, v)
+}
+
+// DecodeInput decodes a coerced value of the input type, such as an argument
+// value, into the Go value v points to.
+//
+// Input objects are decoded into structs, matching fields by their graphql tag,
+// their json tag or their name ignoring case, or into maps. Lists are decoded into
+// slices and arrays, and pointers are allocated for non-null values, so nullable
+// inputs can be told apart from zero values. Optional fields also tell whether a
+// value was provided at all. Enum values are converted to the Go
+// type when possible, otherwise their names are decoded into string types or
+// encoding.TextUnmarshaler implementations. DateTime values are decoded into
+// time.Time.
+func DecodeInput(value any, ttype Input, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return &DecodeError{Message: fmt.Sprintf("expected a non-nil pointer but got: %T", v)}
+	}
+	return decodeValue(value, ttype, rv.Elem(), []any{})
+}
+
+func decodeArgs(values map[string]any, argTypes map[string]Input, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return &DecodeError{Message: fmt.Sprintf("expected a pointer to a struct but got: %T", v)}
+	}
+	rv = rv.Elem()
+	// decode the arguments in a stable order, so the same error is reported
+	// for the same arguments
+	for _, name := range sortedKeys(values) {
+		field, ok := structFieldByName(rv, name)
+		if !ok {
+			continue
+		}
+		if err := decodeValue(values[name], argTypes[name], field, []any{name}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// structFieldByName returns the exported field of the struct with the given
+// graphql or json tag or, failing that, the given name ignoring case.
+func structFieldByName(rv reflect.Value, name string) (reflect.Value, bool) {
+	rt := rv.Type()
+	index := -1
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		tag := strings.Split(field.Tag.Get("graphql"), ",")[0]
+		if tag == "" {
+			tag = extractTag(field.Tag)
+		}
+		if tag == "-" {
+			continue
+		}
+		if tag == name {
+			return rv.Field(i), true
+		}
+		if tag == "" && index < 0 && strings.EqualFold(field.Name, name) {
+			index = i
+		}
+	}
+	if index < 0 {
+		return reflect.Value{}, false
+	}
+	return rv.Field(index), true
+}
+
+// decodeValue decodes the value of the input type into dst. The type may be nil
+// if it is unknown, in which case values are assigned as they are.
+func decodeValue(value any, ttype Input, dst reflect.Value, path []any) error {
+	if nonNull, ok := ttype.(*NonNull); ok {
+		ttype = nonNull.OfType
+	}
+	if dst.CanAddr() {
+		if optional, ok := dst.Addr().Interface().(optionalValue); ok {
+			return optional.decodeOptional(value, ttype, path)
+		}
+	}
+	if value == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return nil
+	}
+
+	switch dst.Kind() {
+	case reflect.Ptr:
+		elem := reflect.New(dst.Type().Elem())
+		if err := decodeValue(value, ttype, elem.Elem(), path); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+	case reflect.Interface:
+		if rv := reflect.ValueOf(value); rv.Type().Implements(dst.Type()) {
+			dst.Set(rv)
+			return nil
+		}
+	}
+
+	switch ttype := ttype.(type) {
+	case *List:
+		return decodeList(value, ttype.OfType, dst, path)
+	case *InputObject:
+		return decodeInputObject(value, ttype, dst, path)
+	case *Enum:
+		if ok, err := decodeAssignable(value, dst, path); ok || err != nil {
+			return err
+		}
+		for _, enumValue := range ttype.Values() {
+			if reflect.DeepEqual(enumValue.Value, value) {
+				return decodeText(enumValue.Name, dst, path)
+			}
+		}
+		return newDecodeError(path, "value of type %T cannot be decoded into %v", value, dst.Type())
+	case nil:
+		switch value.(type) {
+		case []any:
+			return decodeList(value, nil, dst, path)
+		case map[string]any:
+			return decodeInputObject(value, nil, dst, path)
+		}
+	}
+
+	if ok, err := decodeAssignable(value, dst, path); ok || err != nil {
+		return err
+	}
+	if text, ok := value.(string); ok {
+		return decodeText(text, dst, path)
+	}
+	return newDecodeError(path, "value of type %T cannot be decoded into %v", value, dst.Type())
+}
+
+func decodeList(value any, itemType Input, dst reflect.Value, path []any) error {
+	items, ok := value.([]any)
+	if !ok {
+		if rv := reflect.ValueOf(value); rv.Kind() == reflect.Slice {
+			items = make([]any, rv.Len())
+			for i := range items {
+				items[i] = rv.Index(i).Interface()
+			}
+		} else {
+			items = []any{value}
+		}
+	}
+	switch dst.Kind() {
+	case reflect.Slice:
+		slice := reflect.MakeSlice(dst.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := decodeValue(item, itemType, slice.Index(i), append(path, i)); err != nil {
+				return err
+			}
+		}
+		dst.Set(slice)
+		return nil
+	case reflect.Array:
+		if len(items) > dst.Len() {
+			return newDecodeError(path, "%v items cannot be decoded into %v", len(items), dst.Type())
+		}
+		for i, item := range items {
+			if err := decodeValue(item, itemType, dst.Index(i), append(path, i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return newDecodeError(path, "list cannot be decoded into %v", dst.Type())
+}
+
+func decodeInputObject(value any, inputObject *InputObject, dst reflect.Value, path []any) error {
+	fields, ok := value.(map[string]any)
+	if !ok {
+		if ok, err := decodeAssignable(value, dst, path); ok || err != nil {
+			return err
+		}
+		return newDecodeError(path, "value of type %T cannot be decoded into %v", value, dst.Type())
+	}
+	fieldType := func(name string) Input {
+		if inputObject == nil {
+			return nil
+		}
+		if field, ok := inputObject.Fields()[name]; ok {
+			return field.Type
+		}
+		return nil
+	}
+
+	switch dst.Kind() {
+	case reflect.Struct:
+		for _, name := range sortedKeys(fields) {
+			field, ok := structFieldByName(dst, name)
+			if !ok {
+				continue
+			}
+			if err := decodeValue(fields[name], fieldType(name), field, append(path, name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case reflect.Map:
+		if dst.Type().Key().Kind() != reflect.String {
+			break
+		}
+		m := reflect.MakeMapWithSize(dst.Type(), len(fields))
+		for _, name := range sortedKeys(fields) {
+			elem := reflect.New(dst.Type().Elem()).Elem()
+			if err := decodeValue(fields[name], fieldType(name), elem, append(path, name)); err != nil {
+				return err
+			}
+			m.SetMapIndex(reflect.ValueOf(name).Convert(dst.Type().Key()), elem)
+		}
+		dst.Set(m)
+		return nil
+	}
+	return newDecodeError(path, "input object cannot be decoded into %v", dst.Type())
+}
+
+// decodeAssignable assigns or converts the value to dst, if their types allow it.
+// Numbers which dst cannot represent are reported as errors instead of being
+// wrapped or truncated.
+func decodeAssignable(value any, dst reflect.Value, path []any) (bool, error) {
+	rv := reflect.ValueOf(value)
+	switch {
+	case rv.Type().AssignableTo(dst.Type()):
+		dst.Set(rv)
+		return true, nil
+	case isConvertibleKind(rv.Kind(), dst.Kind()) && rv.Type().ConvertibleTo(dst.Type()):
+		if isNumericKind(rv.Kind()) && !isRepresentable(rv, dst) {
+			return false, newDecodeError(path, "%v cannot be represented by %v", value, dst.Type())
+		}
+		dst.Set(rv.Convert(dst.Type()))
+		return true, nil
+	}
+	return false, nil
+}
+
+// isRepresentable reports whether the numeric value rv can be converted to the
+// numeric kind of dst without overflowing or losing its fraction.
+func isRepresentable(rv reflect.Value, dst reflect.Value) bool {
+	switch {
+	case rv.CanInt():
+		i := rv.Int()
+		switch {
+		case dst.CanInt():
+			return !dst.OverflowInt(i)
+		case dst.CanUint():
+			return i >= 0 && !dst.OverflowUint(uint64(i))
+		}
+	case rv.CanUint():
+		u := rv.Uint()
+		switch {
+		case dst.CanInt():
+			return u <= math.MaxInt64 && !dst.OverflowInt(int64(u))
+		case dst.CanUint():
+			return !dst.OverflowUint(u)
+		}
+	case rv.CanFloat():
+		f := rv.Float()
+		switch {
+		case dst.CanInt():
+			return f == math.Trunc(f) && f >= math.MinInt64 && f < math.MaxInt64 && !dst.OverflowInt(int64(f))
+		case dst.CanUint():
+			return f == math.Trunc(f) && f >= 0 && f < math.MaxUint64 && !dst.OverflowUint(uint64(f))
+		case dst.CanFloat():
+			return math.IsInf(f, 0) || math.IsNaN(f) || !dst.OverflowFloat(f)
+		}
+	}
+	return true
+}
+
+// decodeText decodes text into an encoding.TextUnmarshaler or a string type.
+func decodeText(text string, dst reflect.Value, path []any) error {
+	if dst.CanAddr() {
+		if unmarshaler, ok := dst.Addr().Interface().(encoding.TextUnmarshaler); ok {
+			if err := unmarshaler.UnmarshalText([]byte(text)); err != nil {
+				return newDecodeError(path, "%v", err)
+			}
+			return nil
+		}
+	}
+	if dst.Kind() == reflect.String {
+		dst.SetString(text)
+		return nil
+	}
+	return newDecodeError(path, "%q cannot be decoded into %v", text, dst.Type())
+}
+
+// sortedKeys returns the keys of the map in increasing order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func newDecodeError(path []any, format string, a ...any) error {
+	return &DecodeError{
+		Path:    append([]any{}, path...),
+		Message: fmt.Sprintf(format, a...),
+	}
+}
+
+// isConvertibleKind reports whether values of the kind from may be converted to
+// the kind to, which excludes conversions such as integers to strings.
+func isConvertibleKind(from reflect.Kind, to reflect.Kind) bool {
+	switch {
+	case isNumericKind(from):
+		return isNumericKind(to)
+	case from == reflect.String:
+		return to == reflect.String
+	case from == reflect.Bool:
+		return to == reflect.Bool
+	}
+	return false
+}
+
+func isNumericKind(kind reflect.Kind) bool {
+	return isIntegerKind(kind) || kind == reflect.Float32 || kind == reflect.Float64
+}
+
+func isIntegerKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,195 @@
+package graphql_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fraym/graphql-go"
+	"github.com/stretchr/testify/assert"
+)
+
+type decodeTestRole string
+
+type decodeTestLevel int
+
+type decodeTestFilter struct {
+	Roles   []decodeTestRole `graphql:"roles" json:"ignored"`
+	Levels  []decodeTestLevel
+	Since   time.Time  `json:"since"`
+	Until   *time.Time `json:"until"`
+	Limit   *int       `json:"limit"`
+	Deleted *bool      `json:"deleted"`
+	Nested  *decodeTestFilter
+}
+
+type decodeTestArgs struct {
+	Filter decodeTestFilter `json:"filter"`
+	IDs    []string         `json:"ids"`
+	Tags   map[string]any   `json:"tags"`
+}
+
+var decodeTestRoleEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "Role",
+	Values: graphql.EnumValueConfigMap{
+		"ADMIN": &graphql.EnumValueConfig{Value: 1},
+		"USER":  &graphql.EnumValueConfig{Value: 2},
+	},
+})
+
+var decodeTestLevelEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "Level",
+	Values: graphql.EnumValueConfigMap{
+		"LOW":  &graphql.EnumValueConfig{Value: 1},
+		"HIGH": &graphql.EnumValueConfig{Value: 2},
+	},
+})
+
+func decodeTestFilterInput() *graphql.InputObject {
+	filter := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Filter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"roles":   &graphql.InputObjectFieldConfig{Type: graphql.NewList(graphql.NewNonNull(decodeTestRoleEnum))},
+			"levels":  &graphql.InputObjectFieldConfig{Type: graphql.NewList(decodeTestLevelEnum)},
+			"since":   &graphql.InputObjectFieldConfig{Type: graphql.DateTime},
+			"until":   &graphql.InputObjectFieldConfig{Type: graphql.DateTime},
+			"limit":   &graphql.InputObjectFieldConfig{Type: graphql.Int},
+			"deleted": &graphql.InputObjectFieldConfig{Type: graphql.Boolean},
+		},
+	})
+	filter.AddFieldConfig("nested", &graphql.InputObjectFieldConfig{Type: filter})
+	return filter
+}
+
+func decodeTestSchema(t *testing.T, resolve graphql.FieldResolveFn) graphql.Schema {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"search": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						&graphql.ArgumentConfig{Name: "filter", Type: decodeTestFilterInput()},
+						&graphql.ArgumentConfig{Name: "ids", Type: graphql.NewList(graphql.ID)},
+						&graphql.ArgumentConfig{Name: "tags", Type: graphql.NewInputObject(graphql.InputObjectConfig{
+							Name: "Tags",
+							Fields: graphql.InputObjectConfigFieldMap{
+								"team": &graphql.InputObjectFieldConfig{Type: graphql.String},
+							},
+						})},
+					},
+					Resolve: resolve,
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return schema
+}
+
+func TestResolveParams_DecodeArgs(t *testing.T) {
+	var args decodeTestArgs
+	schema := decodeTestSchema(t, func(p graphql.ResolveParams) (any, error) {
+		return nil, p.DecodeArgs(&args)
+	})
+	result := graphql.Do(graphql.Params{
+		Schema: schema,
+		RequestString: `query ($until: DateTime) {
+			search(
+				filter: {
+					roles: [ADMIN, USER]
+					levels: [HIGH]
+					since: "2024-01-02T03:04:05Z"
+					until: $until
+					deleted: false
+					nested: { limit: 3 }
+				}
+				ids: 7
+				tags: { team: "core" }
+			)
+		}`,
+		VariableValues: map[string]any{"until": "2024-02-03T04:05:06Z"},
+	})
+	assert.Empty(t, result.Errors)
+
+	limit, deleted := 3, false
+	until := time.Date(2024, 2, 3, 4, 5, 6, 0, time.UTC)
+	assert.Equal(t, decodeTestArgs{
+		Filter: decodeTestFilter{
+			Roles:   []decodeTestRole{"ADMIN", "USER"},
+			Levels:  []decodeTestLevel{2},
+			Since:   time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Until:   &until,
+			Deleted: &deleted,
+			Nested:  &decodeTestFilter{Limit: &limit},
+		},
+		IDs:  []string{"7"},
+		Tags: map[string]any{"team": "core"},
+	}, args)
+}
+
+func TestResolveParams_DecodeArgsReportsPath(t *testing.T) {
+	var args struct {
+		Filter struct {
+			Nested struct {
+				Levels []bool
+			}
+		}
+	}
+	schema := decodeTestSchema(t, func(p graphql.ResolveParams) (any, error) {
+		return nil, p.DecodeArgs(&args)
+	})
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ search(filter: { nested: { levels: [LOW, HIGH] } }) }`,
+	})
+	if assert.Len(t, result.Errors, 1) {
+		assert.Equal(t, `Cannot decode "filter.nested.levels.0": "LOW" cannot be decoded into bool`, result.Errors[0].Message)
+	}
+
+	err := graphql.DecodeInput(map[string]any{"limit": "ten"}, decodeTestFilterInput(), &decodeTestFilter{})
+	if decodeErr, ok := err.(*graphql.DecodeError); assert.True(t, ok) {
+		assert.Equal(t, []any{"limit"}, decodeErr.Path)
+	}
+	assert.EqualError(t, graphql.DecodeInput(nil, graphql.Int, decodeTestFilter{}),
+		"Cannot decode value: expected a non-nil pointer but got: graphql_test.decodeTestFilter")
+}
+
+func TestDecodeInput_ReportsNumbersOutOfRange(t *testing.T) {
+	var small uint8
+	assert.EqualError(t, graphql.DecodeInput(int64(300), graphql.Int, &small), "Cannot decode value: 300 cannot be represented by uint8")
+	var unsigned uint
+	assert.EqualError(t, graphql.DecodeInput(int64(-1), graphql.Int, &unsigned), "Cannot decode value: -1 cannot be represented by uint")
+	var integer int
+	assert.EqualError(t, graphql.DecodeInput(1.5, graphql.Float, &integer), "Cannot decode value: 1.5 cannot be represented by int")
+	var single float32
+	assert.EqualError(t, graphql.DecodeInput(1e300, graphql.Float, &single), "Cannot decode value: 1e+300 cannot be represented by float32")
+
+	assert.NoError(t, graphql.DecodeInput(int64(255), graphql.Int, &small))
+	assert.Equal(t, uint8(255), small)
+	assert.NoError(t, graphql.DecodeInput(2.0, graphql.Float, &integer))
+	assert.Equal(t, 2, integer)
+
+	var filter struct {
+		Limit  uint8
+		Levels []uint
+	}
+	err := graphql.DecodeInput(map[string]any{"levels": []any{int64(1), int64(-2)}}, nil, &filter)
+	if decodeErr, ok := err.(*graphql.DecodeError); assert.True(t, ok) {
+		assert.Equal(t, []any{"levels", 1}, decodeErr.Path)
+	}
+}
+
+func TestResolveParams_DecodeArgsReportsErrorsInStableOrder(t *testing.T) {
+	var args struct {
+		A uint8
+		B uint8
+		C uint8
+	}
+	values := map[string]any{"c": int64(-1), "a": int64(256), "b": int64(-2)}
+	for i := 0; i < 10; i++ {
+		err := graphql.ResolveParams{Args: values}.DecodeArgs(&args)
+		assert.EqualError(t, err, `Cannot decode "a": 256 cannot be represented by uint8`)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"time"
 
 	"github.com/fraym/graphql-go/language/ast"
 )
@@ -215,8 +216,9 @@ func GetNamed(ttype Type) Named {
 //	  }
 //	});
 type Scalar struct {
-	PrivateName        string `json:"name"`
-	PrivateDescription string `json:"description"`
+	PrivateName           string `json:"name"`
+	PrivateDescription    string `json:"description"`
+	PrivateSpecifiedByURL string `json:"specifiedByURL"`
 
 	scalarConfig ScalarConfig
 	err          error
@@ -238,6 +240,12 @@ type ScalarConfig struct {
 	Serialize    SerializeFn
 	ParseValue   ParseValueFn
 	ParseLiteral ParseLiteralFn
+
+	// SpecifiedByURL points to a specification of the data format, serialization
+	// and coercion rules of the scalar. It is exposed through @specifiedBy.
+	SpecifiedByURL string `json:"specifiedByURL"`
+
+	AppliedDirectives []*AppliedDirective `json:"appliedDirectives"`
 }
 
 // NewScalar creates a new GraphQLScalar
@@ -257,6 +265,7 @@ func NewScalar(config ScalarConfig) *Scalar {
 
 	st.PrivateName = config.Name
 	st.PrivateDescription = config.Description
+	st.PrivateSpecifiedByURL = config.SpecifiedByURL
 
 	err = invariantf(
 		config.Serialize != nil,
@@ -312,6 +321,15 @@ func (st *Scalar) Description() string {
 	return st.PrivateDescription
 }
 
+func (st *Scalar) SpecifiedByURL() string {
+	return st.PrivateSpecifiedByURL
+}
+
+// AppliedDirectives returns the directives applied to the scalar.
+func (st *Scalar) AppliedDirectives() []*AppliedDirective {
+	return st.scalarConfig.AppliedDirectives
+}
+
 func (st *Scalar) String() string {
 	return st.PrivateName
 }
@@ -389,11 +407,12 @@ type IsTypeOfFn func(p IsTypeOfParams) bool
 type InterfacesThunk func() []*Interface
 
 type ObjectConfig struct {
-	Name        string     `json:"name"`
-	Interfaces  any        `json:"interfaces"`
-	Fields      any        `json:"fields"`
-	IsTypeOf    IsTypeOfFn `json:"isTypeOf"`
-	Description string     `json:"description"`
+	Name              string              `json:"name"`
+	Interfaces        any                 `json:"interfaces"`
+	Fields            any                 `json:"fields"`
+	IsTypeOf          IsTypeOfFn          `json:"isTypeOf"`
+	Description       string              `json:"description"`
+	AppliedDirectives []*AppliedDirective `json:"appliedDirectives"`
 }
 
 type FieldsThunk func() Fields
@@ -445,6 +464,11 @@ func (gt *Object) Description() string {
 	return ""
 }
 
+// AppliedDirectives returns the directives applied to the object.
+func (gt *Object) AppliedDirectives() []*AppliedDirective {
+	return gt.typeConfig.AppliedDirectives
+}
+
 func (gt *Object) String() string {
 	return gt.PrivateName
 }
@@ -494,7 +518,7 @@ func (gt *Object) Error() error {
 	return gt.err
 }
 
-func defineInterfaces(ttype *Object, interfaces []*Interface) ([]*Interface, error) {
+func defineInterfaces(ttype Type, interfaces []*Interface) ([]*Interface, error) {
 	ifaces := []*Interface{}
 
 	if len(interfaces) == 0 {
@@ -508,6 +532,13 @@ func defineInterfaces(ttype *Object, interfaces []*Interface) ([]*Interface, err
 		if err != nil {
 			return ifaces, err
 		}
+		err = invariantf(
+			iface.Name() != ttype.Name(),
+			`Type %v cannot implement itself because it would create a circular reference.`, ttype,
+		)
+		if err != nil {
+			return ifaces, err
+		}
 		if iface.ResolveType != nil {
 			err = invariantf(
 				iface.ResolveType != nil,
@@ -554,6 +585,13 @@ func defineFieldMap(ttype Named, fieldMap Fields) (FieldDefinitionMap, error) {
 		if err = assertValidName(fieldName); err != nil {
 			return resultFieldMap, err
 		}
+		err = invariantf(
+			field.goType == nil || isGoTypeCompatible(field.goType, field.Type),
+			`%v.%v resolves values of Go type %v which are not compatible with type %v.`, ttype, fieldName, field.goType, field.Type,
+		)
+		if err != nil {
+			return resultFieldMap, err
+		}
 		fieldDef := &FieldDefinition{
 			Name:              fieldName,
 			Description:       field.Description,
@@ -561,6 +599,9 @@ func defineFieldMap(ttype Named, fieldMap Fields) (FieldDefinitionMap, error) {
 			Resolve:           field.Resolve,
 			Subscribe:         field.Subscribe,
 			DeprecationReason: field.DeprecationReason,
+			AppliedDirectives: field.AppliedDirectives,
+			IsVisible:         field.IsVisible,
+			Timeout:           field.Timeout,
 		}
 
 		fieldDef.Args = []*Argument{}
@@ -580,11 +621,19 @@ func defineFieldMap(ttype Named, fieldMap Fields) (FieldDefinitionMap, error) {
 			); err != nil {
 				return resultFieldMap, err
 			}
+			if err = invariantf(
+				!isRequiredInput(arg.Type, arg.DefaultValue) || arg.DeprecationReason == "",
+				`Required argument %v.%v(%v:) cannot be deprecated.`, ttype, fieldName, arg.Name,
+			); err != nil {
+				return resultFieldMap, err
+			}
 			fieldArg := &Argument{
 				PrivateName:        arg.Name,
 				PrivateDescription: arg.Description,
 				Type:               arg.Type,
 				DefaultValue:       arg.DefaultValue,
+				DeprecationReason:  arg.DeprecationReason,
+				AppliedDirectives:  arg.AppliedDirectives,
 			}
 			fieldDef.Args = append(fieldDef.Args, fieldArg)
 		}
@@ -593,12 +642,22 @@ func defineFieldMap(ttype Named, fieldMap Fields) (FieldDefinitionMap, error) {
 	return resultFieldMap, nil
 }
 
+// isRequiredInput reports whether an argument or input field of the given type
+// and default value must always be provided.
+func isRequiredInput(ttype Input, defaultValue any) bool {
+	_, isNonNull := ttype.(*NonNull)
+	return isNonNull && defaultValue == nil
+}
+
 // ResolveParams Params for FieldResolveFn()
 type ResolveParams struct {
 	// Source is the source value
 	Source any
 
-	// Args is a map of arguments for current GraphQL request
+	// Args is a map of arguments for current GraphQL request.
+	// Arguments which were neither provided nor have a default value are left
+	// out, arguments provided as null are set to nil. The same holds for the
+	// fields of input object values.
 	Args map[string]any
 
 	// Info is a collection of information about the current execution state.
@@ -612,17 +671,30 @@ type ResolveParams struct {
 
 type FieldResolveFn func(p ResolveParams) (any, error)
 
+// IsVisibleParams Params for IsVisibleFn()
+type IsVisibleParams struct {
+	// ParentType is the object or interface type the field is selected on.
+	ParentType Composite
+
+	// Context argument is the context value provided to the request.
+	// It is commonly used to represent an authenticated user.
+	Context context.Context
+}
+
+type IsVisibleFn func(p IsVisibleParams) bool
+
 type ResolveInfo struct {
-	FieldName      string
-	FieldASTs      []*ast.Field
-	Path           *ResponsePath
-	ReturnType     Output
-	ParentType     Composite
-	Schema         Schema
-	Fragments      map[string]ast.Definition
-	RootValue      any
-	Operation      ast.Definition
-	VariableValues map[string]any
+	FieldName       string
+	FieldASTs       []*ast.Field
+	FieldDefinition *FieldDefinition
+	Path            *ResponsePath
+	ReturnType      Output
+	ParentType      Composite
+	Schema          Schema
+	Fragments       map[string]ast.Definition
+	RootValue       any
+	Operation       ast.Definition
+	VariableValues  map[string]any
 }
 
 type Fields map[string]*Field
@@ -635,30 +707,64 @@ type Field struct {
 	Subscribe         FieldResolveFn      `json:"-"`
 	DeprecationReason string              `json:"deprecationReason"`
 	Description       string              `json:"description"`
+	AppliedDirectives []*AppliedDirective `json:"appliedDirectives"`
+
+	// IsVisible hides the field from requests it returns false for. Hidden fields
+	// are left out of introspection, rejected by validation and not executed.
+	IsVisible IsVisibleFn `json:"-"`
+
+	// Timeout limits the time the resolver of the field, and the thunk it
+	// returns, may take. The context passed to the resolver is done once the
+	// timeout expires, and the field resolves to an error without waiting for
+	// the resolver to return.
+	Timeout time.Duration `json:"-"`
+
+	// goType is the Go type resolved by fields created with NewField.
+	goType reflect.Type
 }
 
 type FieldConfigArgument []*ArgumentConfig
 
 type ArgumentConfig struct {
-	Name         string
-	Type         Input  `json:"type"`
-	DefaultValue any    `json:"defaultValue"`
-	Description  string `json:"description"`
+	Name              string
+	Type              Input               `json:"type"`
+	DefaultValue      any                 `json:"defaultValue"`
+	Description       string              `json:"description"`
+	DeprecationReason string              `json:"deprecationReason"`
+	AppliedDirectives []*AppliedDirective `json:"appliedDirectives"`
 }
 
 type (
 	FieldDefinitionMap map[string]*FieldDefinition
 	FieldDefinition    struct {
-		Name              string         `json:"name"`
-		Description       string         `json:"description"`
-		Type              Output         `json:"type"`
-		Args              []*Argument    `json:"args"`
-		Resolve           FieldResolveFn `json:"-"`
-		Subscribe         FieldResolveFn `json:"-"`
-		DeprecationReason string         `json:"deprecationReason"`
+		Name              string              `json:"name"`
+		Description       string              `json:"description"`
+		Type              Output              `json:"type"`
+		Args              []*Argument         `json:"args"`
+		Resolve           FieldResolveFn      `json:"-"`
+		Subscribe         FieldResolveFn      `json:"-"`
+		DeprecationReason string              `json:"deprecationReason"`
+		AppliedDirectives []*AppliedDirective `json:"appliedDirectives"`
+		IsVisible         IsVisibleFn         `json:"-"`
+		Timeout           time.Duration       `json:"-"`
 	}
 )
 
+// isFieldVisible reports whether the field, selected on the parent type, is
+// visible to the request the context belongs to.
+func isFieldVisible(ctx context.Context, parentType Composite, fieldDef *FieldDefinition) bool {
+	if fieldDef == nil || fieldDef.IsVisible == nil {
+		return true
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return fieldDef.IsVisible(IsVisibleParams{
+		ParentType: parentType,
+		Context:    ctx,
+	})
+}
+
 type FieldArgument struct {
 	Name         string `json:"name"`
 	Type         Type   `json:"type"`
@@ -667,10 +773,12 @@ type FieldArgument struct {
 }
 
 type Argument struct {
-	PrivateName        string `json:"name"`
-	Type               Input  `json:"type"`
-	DefaultValue       any    `json:"defaultValue"`
-	PrivateDescription string `json:"description"`
+	PrivateName        string              `json:"name"`
+	Type               Input               `json:"type"`
+	DefaultValue       any                 `json:"defaultValue"`
+	PrivateDescription string              `json:"description"`
+	DeprecationReason  string              `json:"deprecationReason"`
+	AppliedDirectives  []*AppliedDirective `json:"appliedDirectives"`
 }
 
 func (st *Argument) Name() string {
@@ -709,16 +817,20 @@ type Interface struct {
 	PrivateDescription string `json:"description"`
 	ResolveType        ResolveTypeFn
 
-	typeConfig        InterfaceConfig
-	initialisedFields bool
-	fields            FieldDefinitionMap
-	err               error
+	typeConfig            InterfaceConfig
+	initialisedFields     bool
+	fields                FieldDefinitionMap
+	initialisedInterfaces bool
+	interfaces            []*Interface
+	err                   error
 }
 type InterfaceConfig struct {
-	Name        string `json:"name"`
-	Fields      any    `json:"fields"`
-	ResolveType ResolveTypeFn
-	Description string `json:"description"`
+	Name              string `json:"name"`
+	Interfaces        any    `json:"interfaces"`
+	Fields            any    `json:"fields"`
+	ResolveType       ResolveTypeFn
+	Description       string              `json:"description"`
+	AppliedDirectives []*AppliedDirective `json:"appliedDirectives"`
 }
 
 // ResolveTypeParams Params for ResolveTypeFn()
@@ -791,6 +903,35 @@ func (it *Interface) Fields() (fields FieldDefinitionMap) {
 	return it.fields
 }
 
+// Interfaces returns the interfaces implemented by the interface.
+func (it *Interface) Interfaces() []*Interface {
+	if it.initialisedInterfaces {
+		return it.interfaces
+	}
+
+	var configInterfaces []*Interface
+	switch iface := it.typeConfig.Interfaces.(type) {
+	case InterfacesThunk:
+		configInterfaces = iface()
+	case []*Interface:
+		configInterfaces = iface
+	case nil:
+	default:
+		it.err = fmt.Errorf("unknown Interface.Interfaces type: %T", it.typeConfig.Interfaces)
+		it.initialisedInterfaces = true
+		return nil
+	}
+
+	it.interfaces, it.err = defineInterfaces(it, configInterfaces)
+	it.initialisedInterfaces = true
+	return it.interfaces
+}
+
+// AppliedDirectives returns the directives applied to the interface.
+func (it *Interface) AppliedDirectives() []*AppliedDirective {
+	return it.typeConfig.AppliedDirectives
+}
+
 func (it *Interface) String() string {
 	return it.PrivateName
 }
@@ -835,10 +976,11 @@ type Union struct {
 type UnionTypesThunk func() []*Object
 
 type UnionConfig struct {
-	Name        string `json:"name"`
-	Types       any    `json:"types"`
-	ResolveType ResolveTypeFn
-	Description string `json:"description"`
+	Name              string `json:"name"`
+	Types             any    `json:"types"`
+	ResolveType       ResolveTypeFn
+	Description       string              `json:"description"`
+	AppliedDirectives []*AppliedDirective `json:"appliedDirectives"`
 }
 
 func NewUnion(config UnionConfig) *Union {
@@ -928,6 +1070,11 @@ func (ut *Union) Description() string {
 	return ut.PrivateDescription
 }
 
+// AppliedDirectives returns the directives applied to the union.
+func (ut *Union) AppliedDirectives() []*AppliedDirective {
+	return ut.typeConfig.AppliedDirectives
+}
+
 func (ut *Union) Error() error {
 	return ut.err
 }
@@ -966,22 +1113,25 @@ type Enum struct {
 type (
 	EnumValueConfigMap map[string]*EnumValueConfig
 	EnumValueConfig    struct {
-		Value             any    `json:"value"`
-		DeprecationReason string `json:"deprecationReason"`
-		Description       string `json:"description"`
+		Value             any                 `json:"value"`
+		DeprecationReason string              `json:"deprecationReason"`
+		Description       string              `json:"description"`
+		AppliedDirectives []*AppliedDirective `json:"appliedDirectives"`
 	}
 )
 
 type EnumConfig struct {
-	Name        string             `json:"name"`
-	Values      EnumValueConfigMap `json:"values"`
-	Description string             `json:"description"`
+	Name              string              `json:"name"`
+	Values            EnumValueConfigMap  `json:"values"`
+	Description       string              `json:"description"`
+	AppliedDirectives []*AppliedDirective `json:"appliedDirectives"`
 }
 type EnumValueDefinition struct {
-	Name              string `json:"name"`
-	Value             any    `json:"value"`
-	DeprecationReason string `json:"deprecationReason"`
-	Description       string `json:"description"`
+	Name              string              `json:"name"`
+	Value             any                 `json:"value"`
+	DeprecationReason string              `json:"deprecationReason"`
+	Description       string              `json:"description"`
+	AppliedDirectives []*AppliedDirective `json:"appliedDirectives"`
 }
 
 func NewEnum(config EnumConfig) *Enum {
@@ -1028,6 +1178,7 @@ func (gt *Enum) defineEnumValues(valueMap EnumValueConfigMap) ([]*EnumValueDefin
 			Value:             valueConfig.Value,
 			DeprecationReason: valueConfig.DeprecationReason,
 			Description:       valueConfig.Description,
+			AppliedDirectives: valueConfig.AppliedDirectives,
 		}
 		if value.Value == nil {
 			value.Value = valueName
@@ -1089,6 +1240,11 @@ func (gt *Enum) Description() string {
 	return gt.PrivateDescription
 }
 
+// AppliedDirectives returns the directives applied to the enum.
+func (gt *Enum) AppliedDirectives() []*AppliedDirective {
+	return gt.enumConfig.AppliedDirectives
+}
+
 func (gt *Enum) String() string {
 	return gt.PrivateName
 }
@@ -1148,15 +1304,19 @@ type InputObject struct {
 	err        error
 }
 type InputObjectFieldConfig struct {
-	Type         Input  `json:"type"`
-	DefaultValue any    `json:"defaultValue"`
-	Description  string `json:"description"`
+	Type              Input               `json:"type"`
+	DefaultValue      any                 `json:"defaultValue"`
+	Description       string              `json:"description"`
+	DeprecationReason string              `json:"deprecationReason"`
+	AppliedDirectives []*AppliedDirective `json:"appliedDirectives"`
 }
 type InputObjectField struct {
-	PrivateName        string `json:"name"`
-	Type               Input  `json:"type"`
-	DefaultValue       any    `json:"defaultValue"`
-	PrivateDescription string `json:"description"`
+	PrivateName        string              `json:"name"`
+	Type               Input               `json:"type"`
+	DefaultValue       any                 `json:"defaultValue"`
+	PrivateDescription string              `json:"description"`
+	DeprecationReason  string              `json:"deprecationReason"`
+	AppliedDirectives  []*AppliedDirective `json:"appliedDirectives"`
 }
 
 func (st *InputObjectField) Name() string {
@@ -1180,9 +1340,13 @@ type (
 	InputObjectFieldMap            map[string]*InputObjectField
 	InputObjectConfigFieldMapThunk func() InputObjectConfigFieldMap
 	InputObjectConfig              struct {
-		Name        string `json:"name"`
-		Fields      any    `json:"fields"`
-		Description string `json:"description"`
+		Name              string              `json:"name"`
+		Fields            any                 `json:"fields"`
+		Description       string              `json:"description"`
+		AppliedDirectives []*AppliedDirective `json:"appliedDirectives"`
+
+		// IsOneOf requires exactly one field to be given a non-null value.
+		IsOneOf bool `json:"isOneOf"`
 	}
 )
 
@@ -1231,11 +1395,34 @@ func (gt *InputObject) defineFieldMap() InputObjectFieldMap {
 		); gt.err != nil {
 			return resultFieldMap
 		}
+		if gt.typeConfig.IsOneOf {
+			_, isNonNull := fieldConfig.Type.(*NonNull)
+			if gt.err = invariantf(
+				!isNonNull,
+				`OneOf input field %v.%v must be nullable.`, gt, fieldName,
+			); gt.err != nil {
+				return resultFieldMap
+			}
+			if gt.err = invariantf(
+				fieldConfig.DefaultValue == nil,
+				`OneOf input field %v.%v cannot have a default value.`, gt, fieldName,
+			); gt.err != nil {
+				return resultFieldMap
+			}
+		}
+		if gt.err = invariantf(
+			!isRequiredInput(fieldConfig.Type, fieldConfig.DefaultValue) || fieldConfig.DeprecationReason == "",
+			`Required input field %v.%v cannot be deprecated.`, gt, fieldName,
+		); gt.err != nil {
+			return resultFieldMap
+		}
 		field := &InputObjectField{}
 		field.PrivateName = fieldName
 		field.Type = fieldConfig.Type
 		field.PrivateDescription = fieldConfig.Description
 		field.DefaultValue = fieldConfig.DefaultValue
+		field.DeprecationReason = fieldConfig.DeprecationReason
+		field.AppliedDirectives = fieldConfig.AppliedDirectives
 		resultFieldMap[fieldName] = field
 	}
 	gt.init = true
@@ -1265,6 +1452,16 @@ func (gt *InputObject) Name() string {
 	return gt.PrivateName
 }
 
+// IsOneOf reports whether exactly one field of the input object must be given a non-null value.
+func (gt *InputObject) IsOneOf() bool {
+	return gt.typeConfig.IsOneOf
+}
+
+// AppliedDirectives returns the directives applied to the input object.
+func (gt *InputObject) AppliedDirectives() []*AppliedDirective {
+	return gt.typeConfig.AppliedDirectives
+}
+
 func (gt *InputObject) Description() string {
 	return gt.PrivateDescription
 }
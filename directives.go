@@ -32,15 +32,22 @@ var SpecifiedDirectives = []*Directive{
 	IncludeDirective,
 	SkipDirective,
 	DeprecatedDirective,
+	SpecifiedByDirective,
+	OneOfDirective,
 }
 
 // Directive structs are used by the GraphQL runtime as a way of modifying execution
 // behavior. Type system creators will usually not create these directly.
 type Directive struct {
-	Name        string      `json:"name"`
-	Description string      `json:"description"`
-	Locations   []string    `json:"locations"`
-	Args        []*Argument `json:"args"`
+	Name         string      `json:"name"`
+	Description  string      `json:"description"`
+	Locations    []string    `json:"locations"`
+	Args         []*Argument `json:"args"`
+	IsRepeatable bool        `json:"isRepeatable"`
+
+	// TransformField rewrites the fields the directive is applied to when the
+	// schema is passed to TransformSchema.
+	TransformField FieldTransformFn `json:"-"`
 
 	err error
 }
@@ -51,6 +58,13 @@ type DirectiveConfig struct {
 	Description string              `json:"description"`
 	Locations   []string            `json:"locations"`
 	Args        FieldConfigArgument `json:"args"`
+
+	// IsRepeatable allows the directive to be used more than once at a single location.
+	IsRepeatable bool `json:"isRepeatable"`
+
+	// TransformField rewrites the fields the directive is applied to when the
+	// schema is passed to TransformSchema.
+	TransformField FieldTransformFn `json:"-"`
 }
 
 func NewDirective(config DirectiveConfig) *Directive {
@@ -77,11 +91,19 @@ func NewDirective(config DirectiveConfig) *Directive {
 		if dir.err = assertValidName(arg.Name); dir.err != nil {
 			return dir
 		}
+		if dir.err = invariantf(
+			!isRequiredInput(arg.Type, arg.DefaultValue) || arg.DeprecationReason == "",
+			`Required argument @%v(%v:) cannot be deprecated.`, config.Name, arg.Name,
+		); dir.err != nil {
+			return dir
+		}
 		args = append(args, &Argument{
 			PrivateName:        arg.Name,
 			PrivateDescription: arg.Description,
 			Type:               arg.Type,
 			DefaultValue:       arg.DefaultValue,
+			DeprecationReason:  arg.DeprecationReason,
+			AppliedDirectives:  arg.AppliedDirectives,
 		})
 	}
 
@@ -89,9 +111,20 @@ func NewDirective(config DirectiveConfig) *Directive {
 	dir.Description = config.Description
 	dir.Locations = config.Locations
 	dir.Args = args
+	dir.IsRepeatable = config.IsRepeatable
+	dir.TransformField = config.TransformField
 	return dir
 }
 
+// AppliedDirective is the application of a directive to a schema element,
+// e.g. `@auth(role: ADMIN)` on an object type or `@cost(weight: 5)` on a field.
+// The directive must be known to the schema and allowed at the location of the
+// element it is applied to; NewSchema validates both, as well as the arguments.
+type AppliedDirective struct {
+	Name string         `json:"name"`
+	Args map[string]any `json:"args"`
+}
+
 // IncludeDirective is used to conditionally include fields or fragments.
 var IncludeDirective = NewDirective(DirectiveConfig{
 	Name: "include",
@@ -146,6 +179,35 @@ var DeprecatedDirective = NewDirective(DirectiveConfig{
 	},
 	Locations: []string{
 		DirectiveLocationFieldDefinition,
+		DirectiveLocationArgumentDefinition,
+		DirectiveLocationInputFieldDefinition,
 		DirectiveLocationEnumValue,
 	},
 })
+
+// SpecifiedByDirective is used within the type system definition language to
+// provide a URL for specifying the behaviour of custom scalar definitions.
+var SpecifiedByDirective = NewDirective(DirectiveConfig{
+	Name:        "specifiedBy",
+	Description: "Exposes a URL that specifies the behaviour of this scalar.",
+	Args: FieldConfigArgument{
+		&ArgumentConfig{
+			Name:        "url",
+			Type:        NewNonNull(String),
+			Description: "The URL that specifies the behaviour of this scalar.",
+		},
+	},
+	Locations: []string{
+		DirectiveLocationScalar,
+	},
+})
+
+// OneOfDirective is used within the type system definition language to indicate
+// an input object of which exactly one field must be given a non-null value.
+var OneOfDirective = NewDirective(DirectiveConfig{
+	Name:        "oneOf",
+	Description: "Indicates exactly one field must be supplied and this field must not be `null`.",
+	Locations: []string{
+		DirectiveLocationInputObject,
+	},
+})
@@ -0,0 +1,155 @@
+package main
+
+//go:generate go run ../../cmd/graphql-gen -package main -out schema_gen.go schema.graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fraym/graphql-go"
+)
+
+var (
+	ada  = &User{ID: "user-1", Name: "Ada", Role: RoleAdmin}
+	alan = &User{ID: "user-2", Name: "Alan", Role: RoleMember}
+
+	users = []*User{ada, alan}
+	todos = []*Todo{
+		{ID: "todo-1", Text: "Write the analytical engine notes", Done: true, CreatedAt: time.Date(1843, 9, 1, 0, 0, 0, 0, time.UTC), Owner: ada},
+		{ID: "todo-2", Text: "Break the enigma", CreatedAt: time.Date(1939, 9, 4, 0, 0, 0, 0, time.UTC), Owner: alan},
+	}
+)
+
+type userResolver struct{}
+
+func (userResolver) Todos(ctx context.Context, obj *User, args UserTodosArgs) ([]*Todo, error) {
+	result := []*Todo{}
+	for _, todo := range todos {
+		if todo.Owner == obj && (args.Done == nil || todo.Done == *args.Done) {
+			result = append(result, todo)
+		}
+	}
+	return result, nil
+}
+
+type queryResolver struct{}
+
+func (queryResolver) Node(ctx context.Context, args QueryNodeArgs) (Node, error) {
+	for _, user := range users {
+		if user.ID == args.ID {
+			return user, nil
+		}
+	}
+	for _, todo := range todos {
+		if todo.ID == args.ID {
+			return todo, nil
+		}
+	}
+	return nil, nil
+}
+
+func (queryResolver) Search(ctx context.Context, args QuerySearchArgs) ([]SearchResult, error) {
+	result := []SearchResult{}
+	for _, user := range users {
+		if strings.Contains(user.Name, args.Text) {
+			result = append(result, user)
+		}
+	}
+	for _, todo := range todos {
+		if strings.Contains(todo.Text, args.Text) {
+			result = append(result, todo)
+		}
+	}
+	if args.Limit != nil && len(result) > *args.Limit {
+		result = result[:*args.Limit]
+	}
+	return result, nil
+}
+
+func (queryResolver) Users(ctx context.Context, args QueryUsersArgs) ([]*User, error) {
+	result := []*User{}
+	for _, user := range users {
+		if args.Role == nil || user.Role == *args.Role {
+			result = append(result, user)
+		}
+	}
+	return result, nil
+}
+
+type mutationResolver struct{}
+
+func (mutationResolver) CreateTodo(ctx context.Context, args MutationCreateTodoArgs) (*Todo, error) {
+	todo := &Todo{
+		ID:        "todo-" + strconv.Itoa(len(todos)+1),
+		Text:      args.Input.Text,
+		CreatedAt: time.Now().UTC(),
+		DueAt:     args.Input.DueAt,
+	}
+	if args.Input.OwnerID != nil {
+		for _, user := range users {
+			if user.ID == *args.Input.OwnerID {
+				todo.Owner = user
+			}
+		}
+	}
+	todos = append(todos, todo)
+	return todo, nil
+}
+
+func (mutationResolver) CompleteTodo(ctx context.Context, args MutationCompleteTodoArgs) (*Todo, error) {
+	for _, todo := range todos {
+		if todo.ID == args.ID {
+			todo.Done = true
+			return todo, nil
+		}
+	}
+	return nil, nil
+}
+
+func main() {
+	// the schema is generated from schema.graphql, see schema_gen.go
+	schema, err := NewSchema(Resolvers{
+		User:     userResolver{},
+		Query:    queryResolver{},
+		Mutation: mutationResolver{},
+	})
+	if err != nil {
+		log.Fatalf("failed to create new schema, error: %v", err)
+	}
+
+	query := `
+		mutation {
+			createTodo(input: { text: "Compile the schema", ownerId: "user-1" }) {
+				id
+				owner { name todos(done: false) { text } }
+			}
+		}
+	`
+	r := graphql.Do(graphql.Params{Schema: schema, RequestString: query})
+	if len(r.Errors) > 0 {
+		log.Fatalf("failed to execute graphql operation, errors: %+v", r.Errors)
+	}
+	rJSON, _ := json.Marshal(r)
+	fmt.Printf("%s \n", rJSON)
+
+	query = `
+		{
+			search(text: "a") {
+				__typename
+				... on User { name role }
+				... on Todo { text done }
+			}
+		}
+	`
+	r = graphql.Do(graphql.Params{Schema: schema, RequestString: query})
+	if len(r.Errors) > 0 {
+		log.Fatalf("failed to execute graphql operation, errors: %+v", r.Errors)
+	}
+	rJSON, _ = json.Marshal(r)
+	fmt.Printf("%s \n", rJSON)
+}
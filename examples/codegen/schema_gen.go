@@ -0,0 +1,246 @@
+// Code generated by graphql-gen. DO NOT EDIT.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fraym/graphql-go"
+)
+
+// A node with a globally unique id.
+type Node interface {
+	IsNode()
+}
+
+type Role string
+
+const (
+	RoleAdmin  Role = "ADMIN"
+	RoleMember Role = "MEMBER"
+	// Deprecated: Use MEMBER.
+	RoleGuest Role = "GUEST"
+)
+
+// A user of the todo app.
+type User struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	Role Role   `json:"role"`
+}
+
+func (*User) IsNode() {}
+
+func (*User) IsSearchResult() {}
+
+// UserResolver resolves the fields of User that take arguments.
+type UserResolver interface {
+	// The todos of the user, optionally filtered by their state.
+	Todos(ctx context.Context, obj *User, args UserTodosArgs) ([]*Todo, error)
+}
+
+// UserTodosArgs holds the arguments of User.todos.
+type UserTodosArgs struct {
+	Done *bool `json:"done"`
+}
+
+type Todo struct {
+	ID        string     `json:"id"`
+	Text      string     `json:"text"`
+	Done      bool       `json:"done"`
+	CreatedAt time.Time  `json:"createdAt"`
+	DueAt     *time.Time `json:"dueAt"`
+	Owner     *User      `json:"owner"`
+}
+
+func (*Todo) IsNode() {}
+
+func (*Todo) IsSearchResult() {}
+
+type SearchResult interface {
+	IsSearchResult()
+}
+
+type NewTodo struct {
+	Text    string     `json:"text"`
+	DueAt   *time.Time `json:"dueAt"`
+	OwnerID *string    `json:"ownerId"`
+}
+
+// QueryResolver resolves the fields of the Query type.
+type QueryResolver interface {
+	Node(ctx context.Context, args QueryNodeArgs) (Node, error)
+	Search(ctx context.Context, args QuerySearchArgs) ([]SearchResult, error)
+	Users(ctx context.Context, args QueryUsersArgs) ([]*User, error)
+}
+
+// QueryNodeArgs holds the arguments of Query.node.
+type QueryNodeArgs struct {
+	ID string `json:"id"`
+}
+
+// QuerySearchArgs holds the arguments of Query.search.
+type QuerySearchArgs struct {
+	Text  string `json:"text"`
+	Limit *int   `json:"limit"`
+}
+
+// QueryUsersArgs holds the arguments of Query.users.
+type QueryUsersArgs struct {
+	Role *Role `json:"role"`
+}
+
+// MutationResolver resolves the fields of the Mutation type.
+type MutationResolver interface {
+	CreateTodo(ctx context.Context, args MutationCreateTodoArgs) (*Todo, error)
+	CompleteTodo(ctx context.Context, args MutationCompleteTodoArgs) (*Todo, error)
+}
+
+// MutationCreateTodoArgs holds the arguments of Mutation.createTodo.
+type MutationCreateTodoArgs struct {
+	Input NewTodo `json:"input"`
+}
+
+// MutationCompleteTodoArgs holds the arguments of Mutation.completeTodo.
+type MutationCompleteTodoArgs struct {
+	ID string `json:"id"`
+}
+
+const schemaSDL = `"""A node with a globally unique id."""
+interface Node {
+  id: ID!
+}
+
+enum Role {
+  ADMIN
+  MEMBER
+  GUEST @deprecated(reason: "Use MEMBER.")
+}
+
+"""A user of the todo app."""
+type User implements Node {
+  id: ID!
+  name: String!
+  role: Role!
+  """The todos of the user, optionally filtered by their state."""
+  todos(done: Boolean): [Todo!]!
+}
+
+type Todo implements Node {
+  id: ID!
+  text: String!
+  done: Boolean!
+  createdAt: DateTime!
+  dueAt: DateTime
+  owner: User
+}
+
+union SearchResult = User | Todo
+
+scalar DateTime
+
+input NewTodo {
+  text: String!
+  dueAt: DateTime
+  ownerId: ID
+}
+
+type Query {
+  node(id: ID!): Node
+  search(text: String!, limit: Int = 10): [SearchResult!]!
+  users(role: Role): [User!]!
+}
+
+type Mutation {
+  createTodo(input: NewTodo!): Todo!
+  completeTodo(id: ID!): Todo
+}
+`
+
+// Resolvers holds the resolvers of the schema.
+type Resolvers struct {
+	User     UserResolver
+	Query    QueryResolver
+	Mutation MutationResolver
+}
+
+// NewSchema builds the schema with its fields resolved by the resolvers.
+func NewSchema(resolvers Resolvers) (graphql.Schema, error) {
+	return graphql.BuildSchema(schemaSDL, graphql.BuildSchemaOptions{
+		Resolvers: map[string]graphql.FieldResolveFn{
+			"User.todos": func(p graphql.ResolveParams) (any, error) {
+				obj, ok := p.Source.(*User)
+				if !ok {
+					return nil, fmt.Errorf("expected source of type *User but got: %T", p.Source)
+				}
+				var args UserTodosArgs
+				if err := p.DecodeArgs(&args); err != nil {
+					return nil, err
+				}
+				return resolvers.User.Todos(p.Context, obj, args)
+			},
+			"Query.node": func(p graphql.ResolveParams) (any, error) {
+				var args QueryNodeArgs
+				if err := p.DecodeArgs(&args); err != nil {
+					return nil, err
+				}
+				return resolvers.Query.Node(p.Context, args)
+			},
+			"Query.search": func(p graphql.ResolveParams) (any, error) {
+				var args QuerySearchArgs
+				if err := p.DecodeArgs(&args); err != nil {
+					return nil, err
+				}
+				return resolvers.Query.Search(p.Context, args)
+			},
+			"Query.users": func(p graphql.ResolveParams) (any, error) {
+				var args QueryUsersArgs
+				if err := p.DecodeArgs(&args); err != nil {
+					return nil, err
+				}
+				return resolvers.Query.Users(p.Context, args)
+			},
+			"Mutation.createTodo": func(p graphql.ResolveParams) (any, error) {
+				var args MutationCreateTodoArgs
+				if err := p.DecodeArgs(&args); err != nil {
+					return nil, err
+				}
+				return resolvers.Mutation.CreateTodo(p.Context, args)
+			},
+			"Mutation.completeTodo": func(p graphql.ResolveParams) (any, error) {
+				var args MutationCompleteTodoArgs
+				if err := p.DecodeArgs(&args); err != nil {
+					return nil, err
+				}
+				return resolvers.Mutation.CompleteTodo(p.Context, args)
+			},
+		},
+		ResolveType: map[string]graphql.ResolveTypeFn{
+			"Node": func(p graphql.ResolveTypeParams) *graphql.Object {
+				switch p.Value.(type) {
+				case *User:
+					return p.Info.Schema.Type("User").(*graphql.Object)
+				case *Todo:
+					return p.Info.Schema.Type("Todo").(*graphql.Object)
+				}
+				return nil
+			},
+			"SearchResult": func(p graphql.ResolveTypeParams) *graphql.Object {
+				switch p.Value.(type) {
+				case *User:
+					return p.Info.Schema.Type("User").(*graphql.Object)
+				case *Todo:
+					return p.Info.Schema.Type("Todo").(*graphql.Object)
+				}
+				return nil
+			},
+		},
+		EnumValues: map[string]any{
+			"Role.ADMIN":  RoleAdmin,
+			"Role.MEMBER": RoleMember,
+			"Role.GUEST":  RoleGuest,
+		},
+	})
+}
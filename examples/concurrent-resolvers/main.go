@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"time"
 
 	"github.com/fraym/graphql-go"
 )
@@ -64,6 +65,16 @@ var QueryType = graphql.NewObject(graphql.ObjectConfig{
 				}, nil
 			},
 		},
+		// `blockingFieldBar` blocks while resolving, it is resolved
+		// concurrently with its siblings as the query is executed with
+		// `MaxConcurrency` set.
+		"blockingFieldBar": &graphql.Field{
+			Type: FieldBarType,
+			Resolve: func(p graphql.ResolveParams) (any, error) {
+				time.Sleep(10 * time.Millisecond)
+				return &Bar{Name: "Bar's other name"}, nil
+			},
+		},
 	},
 })
 
@@ -82,11 +93,16 @@ func main() {
 			concurrentFieldBar {
 				name
 			}
+			blockingFieldBar {
+				name
+			}
 		}
 	`
 	result := graphql.Do(graphql.Params{
-		RequestString: query,
-		Schema:        schema,
+		RequestString:  query,
+		Schema:         schema,
+		MaxConcurrency: 4,
+		OrderedData:    true,
 	})
 	b, err := json.Marshal(result)
 	if err != nil {
@@ -96,11 +112,14 @@ func main() {
 	/*
 		{
 		  "data": {
+		    "concurrentFieldFoo": {
+		      "name": "Foo's name"
+		    },
 		    "concurrentFieldBar": {
 		      "name": "Bar's name"
 		    },
-		    "concurrentFieldFoo": {
-		      "name": "Foo's name"
+		    "blockingFieldBar": {
+		      "name": "Bar's other name"
 		    }
 		  }
 		}
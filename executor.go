@@ -5,8 +5,9 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
-	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/fraym/graphql-go/gqlerrors"
 	"github.com/fraym/graphql-go/language/ast"
@@ -21,15 +22,26 @@ type ExecuteParams struct {
 
 	// Context may be provided to pass application-specific per-request
 	// information to resolve functions.
+	// Once the context is done, no more resolvers are called and the
+	// resolvers still running are abandoned: the fields not resolved yet
+	// resolve to errors at their paths, leaving the data resolved so far in
+	// the result. Abandoned resolvers should return once their p.Context is
+	// done, their results are discarded.
 	Context context.Context
+
+	// MaxConcurrency is the maximum number of field resolvers running at the
+	// same time. If it is greater than 1, sibling fields of query operations
+	// are resolved concurrently, by up to MaxConcurrency goroutines. Fields
+	// of mutations and subscriptions are always resolved one at a time.
+	MaxConcurrency int
+
+	// OrderedData returns the data of the result as an *OrderedMap, which
+	// keeps the fields in the order of the selection set when it is encoded
+	// to JSON. Otherwise the data is a map[string]any.
+	OrderedData bool
 }
 
 func Execute(p ExecuteParams) (result *Result) {
-	// Use background context if no context was provided
-	ctx := p.Context
-	if ctx == nil {
-		ctx = context.Background()
-	}
 	// run executionDidStart functions from extensions
 	extErrs, executionFinishFn := handleExtensionsExecutionDidStart(&p)
 	if len(extErrs) != 0 {
@@ -60,19 +72,21 @@ func Execute(p ExecuteParams) (result *Result) {
 		}()
 
 		exeContext, err := buildExecutionContext(buildExecutionCtxParams{
-			Schema:        p.Schema,
-			Root:          p.Root,
-			AST:           p.AST,
-			OperationName: p.OperationName,
-			Args:          p.Args,
-			Result:        result,
-			Context:       p.Context,
+			Schema:         p.Schema,
+			Root:           p.Root,
+			AST:            p.AST,
+			OperationName:  p.OperationName,
+			Args:           p.Args,
+			Result:         result,
+			Context:        p.Context,
+			MaxConcurrency: p.MaxConcurrency,
 		})
 		if err != nil {
 			result.Errors = append(result.Errors, gqlerrors.FormatError(err))
 			resultChannel <- result
 			return
 		}
+		defer exeContext.cancelTimeouts()
 
 		resultChannel <- executeOperation(executeOperationParams{
 			ExecutionContext: exeContext,
@@ -81,24 +95,22 @@ func Execute(p ExecuteParams) (result *Result) {
 		})
 	}()
 
-	select {
-	case <-ctx.Done():
-		result := &Result{}
-		result.Errors = append(result.Errors, gqlerrors.FormatError(ctx.Err()))
-		return result
-	case r := <-resultChannel:
-		return r
+	result = <-resultChannel
+	if !p.OrderedData {
+		result.Data = unorderedValue(result.Data)
 	}
+	return result
 }
 
 type buildExecutionCtxParams struct {
-	Schema        Schema
-	Root          any
-	AST           *ast.Document
-	OperationName string
-	Args          map[string]any
-	Result        *Result
-	Context       context.Context
+	Schema         Schema
+	Root           any
+	AST            *ast.Document
+	OperationName  string
+	Args           map[string]any
+	Result         *Result
+	Context        context.Context
+	MaxConcurrency int
 }
 
 type executionContext struct {
@@ -109,6 +121,67 @@ type executionContext struct {
 	VariableValues map[string]any
 	Errors         []gqlerrors.FormattedError
 	Context        context.Context
+
+	// errorsMu guards Errors, to which fields resolved concurrently add their
+	// errors.
+	errorsMu sync.Mutex
+	// extensionsMu serializes the calls to the ResolveFieldDidStart and
+	// ResolveFieldFinishFunc functions of extensions, so extensions don't need
+	// to be safe for concurrent use.
+	extensionsMu sync.Mutex
+	// workers holds a token for every goroutine resolving fields besides the
+	// one executing the operation. It is nil if fields are resolved serially.
+	workers chan struct{}
+	// batchers holds the Batchers scheduled with ScheduleFlush. It is nil for
+	// mutations, whose thunks are called depth-first.
+	batchers *batchers
+	// timeouts holds the cancel functions of the contexts of fields with a
+	// Timeout, whose thunks may never be called if their parent is nulled.
+	timeoutsMu sync.Mutex
+	timeouts   []context.CancelFunc
+}
+
+// addErrors adds errors of fields to the errors of the execution.
+func (eCtx *executionContext) addErrors(errs ...gqlerrors.FormattedError) {
+	eCtx.errorsMu.Lock()
+	defer eCtx.errorsMu.Unlock()
+	eCtx.Errors = append(eCtx.Errors, errs...)
+}
+
+// addTimeout adds the cancel function of the context of a field with a
+// Timeout, which is called once the execution is done at the latest.
+func (eCtx *executionContext) addTimeout(cancel context.CancelFunc) {
+	eCtx.timeoutsMu.Lock()
+	defer eCtx.timeoutsMu.Unlock()
+	eCtx.timeouts = append(eCtx.timeouts, cancel)
+}
+
+// cancelTimeouts stops the timers of the contexts of fields with a Timeout.
+func (eCtx *executionContext) cancelTimeouts() {
+	eCtx.timeoutsMu.Lock()
+	defer eCtx.timeoutsMu.Unlock()
+	for _, cancel := range eCtx.timeouts {
+		cancel()
+	}
+	eCtx.timeouts = nil
+}
+
+// acquireWorker reserves a worker to resolve a field in a new goroutine. It
+// returns false if all workers are busy or the context is done.
+func (eCtx *executionContext) acquireWorker(ctx context.Context) bool {
+	if ctx != nil && ctx.Err() != nil {
+		return false
+	}
+	select {
+	case eCtx.workers <- struct{}{}:
+		return true
+	default:
+		return false
+	}
+}
+
+func (eCtx *executionContext) releaseWorker() {
+	<-eCtx.workers
 }
 
 func buildExecutionContext(p buildExecutionCtxParams) (*executionContext, error) {
@@ -154,6 +227,17 @@ func buildExecutionContext(p buildExecutionCtxParams) (*executionContext, error)
 	eCtx.Operation = operation
 	eCtx.VariableValues = variableValues
 	eCtx.Context = p.Context
+	if eCtx.Context == nil {
+		eCtx.Context = context.Background()
+	}
+	if operation.GetOperation() != ast.OperationTypeMutation {
+		eCtx.batchers = &batchers{scheduled: map[Batcher]bool{}}
+		eCtx.Context = context.WithValue(eCtx.Context, batchersKey{}, eCtx.batchers)
+	}
+	if p.MaxConcurrency > 1 && operation.GetOperation() == ast.OperationTypeQuery {
+		// the goroutine executing the operation resolves fields as well
+		eCtx.workers = make(chan struct{}, p.MaxConcurrency-1)
+	}
 	return eCtx, nil
 }
 
@@ -177,6 +261,7 @@ func executeOperation(p executeOperationParams) *Result {
 
 	executeFieldsParams := executeFieldsParams{
 		ExecutionContext: p.ExecutionContext,
+		Context:          p.ExecutionContext.Context,
 		ParentType:       operationType,
 		Source:           p.Root,
 		Fields:           fields,
@@ -237,9 +322,10 @@ func getOperationRootType(schema Schema, operation ast.Definition) (*Object, err
 
 type executeFieldsParams struct {
 	ExecutionContext *executionContext
+	Context          context.Context // context of the parent field
 	ParentType       *Object
 	Source           any
-	Fields           map[string][]*ast.Field
+	Fields           *collectedFields
 	Path             *ResponsePath
 }
 
@@ -249,19 +335,18 @@ func executeFieldsSerially(p executeFieldsParams) *Result {
 		p.Source = map[string]any{}
 	}
 	if p.Fields == nil {
-		p.Fields = map[string][]*ast.Field{}
+		p.Fields = newCollectedFields()
 	}
 
-	finalResults := make(map[string]any, len(p.Fields))
-	for _, orderedField := range orderedFields(p.Fields) {
-		responseName := orderedField.responseName
-		fieldASTs := orderedField.fieldASTs
+	finalResults := newOrderedMap(len(p.Fields.responseNames))
+	for _, responseName := range p.Fields.responseNames {
+		fieldASTs := p.Fields.fieldASTs[responseName]
 		fieldPath := p.Path.WithKey(responseName)
-		resolved, state := resolveField(p.ExecutionContext, p.ParentType, p.Source, fieldASTs, fieldPath)
+		resolved, state := resolveField(p.Context, p.ExecutionContext, p.ParentType, p.Source, fieldASTs, fieldPath)
 		if state.hasNoFieldDefs {
 			continue
 		}
-		finalResults[responseName] = resolved
+		finalResults.Set(responseName, resolved)
 	}
 	dethunkMapDepthFirst(finalResults)
 
@@ -275,7 +360,7 @@ func executeFieldsSerially(p executeFieldsParams) *Result {
 func executeFields(p executeFieldsParams) *Result {
 	finalResults := executeSubFields(p)
 
-	dethunkMapWithBreadthFirstTraversal(finalResults)
+	dethunkMapWithBreadthFirstTraversal(p.ExecutionContext, finalResults)
 
 	return &Result{
 		Data:   finalResults,
@@ -283,24 +368,79 @@ func executeFields(p executeFieldsParams) *Result {
 	}
 }
 
-func executeSubFields(p executeFieldsParams) map[string]any {
+func executeSubFields(p executeFieldsParams) *OrderedMap {
 	if p.Source == nil {
 		p.Source = map[string]any{}
 	}
 	if p.Fields == nil {
-		p.Fields = map[string][]*ast.Field{}
+		p.Fields = newCollectedFields()
+	}
+	if p.ExecutionContext.workers != nil && len(p.Fields.responseNames) > 1 {
+		return executeSubFieldsConcurrently(p)
 	}
 
-	finalResults := make(map[string]any, len(p.Fields))
-	for responseName, fieldASTs := range p.Fields {
+	finalResults := newOrderedMap(len(p.Fields.responseNames))
+	for _, responseName := range p.Fields.responseNames {
+		fieldASTs := p.Fields.fieldASTs[responseName]
 		fieldPath := p.Path.WithKey(responseName)
-		resolved, state := resolveField(p.ExecutionContext, p.ParentType, p.Source, fieldASTs, fieldPath)
+		resolved, state := resolveField(p.Context, p.ExecutionContext, p.ParentType, p.Source, fieldASTs, fieldPath)
 		if state.hasNoFieldDefs {
 			continue
 		}
-		finalResults[responseName] = resolved
+		finalResults.Set(responseName, resolved)
+	}
+
+	return finalResults
+}
+
+// executeSubFieldsConcurrently resolves the fields in new goroutines while
+// workers are available and in the calling goroutine otherwise, so nested
+// selection sets never wait for workers held by their parents.
+func executeSubFieldsConcurrently(p executeFieldsParams) *OrderedMap {
+	eCtx := p.ExecutionContext
+	responseNames := p.Fields.responseNames
+	results := make([]any, len(responseNames))
+	states := make([]resolveFieldResultState, len(responseNames))
+	panics := make([]any, len(responseNames))
+
+	wg := sync.WaitGroup{}
+	for i, responseName := range responseNames {
+		resolve := func() {
+			// errors of non-null fields are raised again in the calling
+			// goroutine to null the parent field
+			defer func() {
+				if r := recover(); r != nil {
+					panics[i] = r
+				}
+			}()
+			fieldPath := p.Path.WithKey(responseName)
+			results[i], states[i] = resolveField(p.Context, eCtx, p.ParentType, p.Source, p.Fields.fieldASTs[responseName], fieldPath)
+		}
+		// the last field is resolved by the calling goroutine, which would
+		// only wait for the others otherwise
+		if i < len(responseNames)-1 && eCtx.acquireWorker(p.Context) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				defer eCtx.releaseWorker()
+				resolve()
+			}()
+			continue
+		}
+		resolve()
 	}
+	wg.Wait()
 
+	finalResults := newOrderedMap(len(responseNames))
+	for i, responseName := range responseNames {
+		if panics[i] != nil {
+			panic(panics[i])
+		}
+		if states[i].hasNoFieldDefs {
+			continue
+		}
+		finalResults.Set(responseName, results[i])
+	}
 	return finalResults
 }
 
@@ -313,32 +453,41 @@ func (d *dethunkQueue) push(f func()) {
 	d.DethunkFuncs = append(d.DethunkFuncs, f)
 }
 
-func (d *dethunkQueue) shift() func() {
-	f := d.DethunkFuncs[0]
-	d.DethunkFuncs = d.DethunkFuncs[1:]
-	return f
+// shiftLevel removes and returns the funcs pushed so far, which descend into
+// the same level of the result.
+func (d *dethunkQueue) shiftLevel() []func() {
+	fs := d.DethunkFuncs
+	d.DethunkFuncs = []func(){}
+	return fs
 }
 
 // dethunkWithBreadthFirstTraversal performs a breadth-first descent of the map, calling any thunks
 // in the map values and replacing each thunk with that thunk's return value. This parallels
 // the reference graphql-js implementation, which calls Promise.all on thunks at each depth (which
 // is an implicit parallel descent).
-func dethunkMapWithBreadthFirstTraversal(finalResults map[string]any) {
+// The Batchers scheduled with ScheduleFlush are flushed before the thunks of each level are
+// called, so the keys loaded by the resolvers of a level are loaded in one batch.
+func dethunkMapWithBreadthFirstTraversal(eCtx *executionContext, finalResults *OrderedMap) {
 	dethunkQueue := &dethunkQueue{DethunkFuncs: []func(){}}
-	dethunkMapBreadthFirst(finalResults, dethunkQueue)
+	dethunkQueue.push(func() { dethunkMapBreadthFirst(finalResults, dethunkQueue) })
 	for len(dethunkQueue.DethunkFuncs) > 0 {
-		f := dethunkQueue.shift()
-		f()
+		// the thunks fail without being called once the context is done
+		if eCtx.batchers != nil && eCtx.Context.Err() == nil {
+			eCtx.batchers.flush()
+		}
+		for _, f := range dethunkQueue.shiftLevel() {
+			f()
+		}
 	}
 }
 
-func dethunkMapBreadthFirst(m map[string]any, dethunkQueue *dethunkQueue) {
-	for k, v := range m {
-		if f, ok := v.(func() any); ok {
-			m[k] = f()
+func dethunkMapBreadthFirst(m *OrderedMap, dethunkQueue *dethunkQueue) {
+	for _, k := range m.keys {
+		if f, ok := m.values[k].(func() any); ok {
+			m.values[k] = f()
 		}
-		switch val := m[k].(type) {
-		case map[string]any:
+		switch val := m.values[k].(type) {
+		case *OrderedMap:
 			dethunkQueue.push(func() { dethunkMapBreadthFirst(val, dethunkQueue) })
 		case []any:
 			dethunkQueue.push(func() { dethunkListBreadthFirst(val, dethunkQueue) })
@@ -352,7 +501,7 @@ func dethunkListBreadthFirst(list []any, dethunkQueue *dethunkQueue) {
 			list[i] = f()
 		}
 		switch val := list[i].(type) {
-		case map[string]any:
+		case *OrderedMap:
 			dethunkQueue.push(func() { dethunkMapBreadthFirst(val, dethunkQueue) })
 		case []any:
 			dethunkQueue.push(func() { dethunkListBreadthFirst(val, dethunkQueue) })
@@ -364,13 +513,13 @@ func dethunkListBreadthFirst(list []any, dethunkQueue *dethunkQueue) {
 // in the map values and replacing each thunk with that thunk's return value. This is needed
 // to conform to the graphql-js reference implementation, which requires serial (depth-first)
 // implementations for mutation selects.
-func dethunkMapDepthFirst(m map[string]any) {
-	for k, v := range m {
-		if f, ok := v.(func() any); ok {
-			m[k] = f()
+func dethunkMapDepthFirst(m *OrderedMap) {
+	for _, k := range m.keys {
+		if f, ok := m.values[k].(func() any); ok {
+			m.values[k] = f()
 		}
-		switch val := m[k].(type) {
-		case map[string]any:
+		switch val := m.values[k].(type) {
+		case *OrderedMap:
 			dethunkMapDepthFirst(val)
 		case []any:
 			dethunkListDepthFirst(val)
@@ -384,7 +533,7 @@ func dethunkListDepthFirst(list []any) {
 			list[i] = f()
 		}
 		switch val := list[i].(type) {
-		case map[string]any:
+		case *OrderedMap:
 			dethunkMapDepthFirst(val)
 		case []any:
 			dethunkListDepthFirst(val)
@@ -396,23 +545,45 @@ type collectFieldsParams struct {
 	ExeContext           *executionContext
 	RuntimeType          *Object // previously known as OperationType
 	SelectionSet         *ast.SelectionSet
-	Fields               map[string][]*ast.Field
+	Fields               *collectedFields
 	VisitedFragmentNames map[string]bool
 }
 
+// collectedFields holds the fields of selection sets grouped by response name,
+// with the response names in the order they were first collected, which is
+// the order of the fields in the result.
+type collectedFields struct {
+	responseNames []string
+	fieldASTs     map[string][]*ast.Field
+}
+
+func newCollectedFields() *collectedFields {
+	return &collectedFields{
+		responseNames: []string{},
+		fieldASTs:     map[string][]*ast.Field{},
+	}
+}
+
+func (c *collectedFields) add(responseName string, fieldAST *ast.Field) {
+	if _, ok := c.fieldASTs[responseName]; !ok {
+		c.responseNames = append(c.responseNames, responseName)
+	}
+	c.fieldASTs[responseName] = append(c.fieldASTs[responseName], fieldAST)
+}
+
 // Given a selectionSet, adds all of the fields in that selection to
 // the passed in map of fields, and returns it at the end.
 // CollectFields requires the "runtime type" of an object. For a field which
 // returns and Interface or Union type, the "runtime type" will be the actual
 // Object type returned by that field.
-func collectFields(p collectFieldsParams) (fields map[string][]*ast.Field) {
+func collectFields(p collectFieldsParams) (fields *collectedFields) {
 	// overlying SelectionSet & Fields to fields
 	if p.SelectionSet == nil {
 		return p.Fields
 	}
 	fields = p.Fields
 	if fields == nil {
-		fields = map[string][]*ast.Field{}
+		fields = newCollectedFields()
 	}
 	if p.VisitedFragmentNames == nil {
 		p.VisitedFragmentNames = map[string]bool{}
@@ -423,11 +594,7 @@ func collectFields(p collectFieldsParams) (fields map[string][]*ast.Field) {
 			if !shouldIncludeNode(p.ExeContext, selection.Directives) {
 				continue
 			}
-			name := getFieldEntryKey(selection)
-			if _, ok := fields[name]; !ok {
-				fields[name] = []*ast.Field{}
-			}
-			fields[name] = append(fields[name], selection)
+			fields.add(getFieldEntryKey(selection), selection)
 		case *ast.InlineFragment:
 
 			if !shouldIncludeNode(p.ExeContext, selection.Directives) ||
@@ -581,14 +748,14 @@ func handleFieldError(r any, fieldNodes []ast.Node, path *ResponsePath, returnTy
 	if _, ok := returnType.(*NonNull); ok {
 		panic(err)
 	}
-	eCtx.Errors = append(eCtx.Errors, gqlerrors.FormatError(err))
+	eCtx.addErrors(gqlerrors.FormatError(err))
 }
 
 // Resolves the field on the given source object. In particular, this
 // figures out the value that the field returns by calling its resolve function,
 // then calls completeValue to complete promises, serialize scalars, or execute
 // the sub-selection-set for objects.
-func resolveField(eCtx *executionContext, parentType *Object, source any, fieldASTs []*ast.Field, path *ResponsePath) (result any, resultState resolveFieldResultState) {
+func resolveField(ctx context.Context, eCtx *executionContext, parentType *Object, source any, fieldASTs []*ast.Field, path *ResponsePath) (result any, resultState resolveFieldResultState) {
 	// catch panic from resolveFn
 	var returnType Output
 	defer func() (any, resolveFieldResultState) {
@@ -605,7 +772,7 @@ func resolveField(eCtx *executionContext, parentType *Object, source any, fieldA
 		fieldName = fieldAST.Name.Value
 	}
 
-	fieldDef := getFieldDef(eCtx.Schema, parentType, fieldName)
+	fieldDef := getFieldDef(ctx, eCtx.Schema, parentType, fieldName)
 	if fieldDef == nil {
 		resultState.hasNoFieldDefs = true
 		return nil, resultState
@@ -616,52 +783,113 @@ func resolveField(eCtx *executionContext, parentType *Object, source any, fieldA
 		resolveFn = DefaultResolveFn
 	}
 
+	// no resolvers are called once the context is done
+	if err := ctx.Err(); err != nil {
+		panic(err)
+	}
+
 	// Build a map of arguments from the field.arguments AST, using the
 	// variables scope to fulfill any variable references.
 	// TODO: find a way to memoize, in case this field is within a List type.
 	args := getArgumentValues(fieldDef.Args, fieldAST.Arguments, eCtx.VariableValues)
 
 	info := ResolveInfo{
-		FieldName:      fieldName,
-		FieldASTs:      fieldASTs,
-		Path:           path,
-		ReturnType:     returnType,
-		ParentType:     parentType,
-		Schema:         eCtx.Schema,
-		Fragments:      eCtx.Fragments,
-		RootValue:      eCtx.Root,
-		Operation:      eCtx.Operation,
-		VariableValues: eCtx.VariableValues,
+		FieldName:       fieldName,
+		FieldASTs:       fieldASTs,
+		FieldDefinition: fieldDef,
+		Path:            path,
+		ReturnType:      returnType,
+		ParentType:      parentType,
+		Schema:          eCtx.Schema,
+		Fragments:       eCtx.Fragments,
+		RootValue:       eCtx.Root,
+		Operation:       eCtx.Operation,
+		VariableValues:  eCtx.VariableValues,
 	}
 
 	var resolveFnError error
 
-	extErrs, resolveFieldFinishFn := handleExtensionsResolveFieldDidStart(eCtx, &info)
+	ctx, extErrs, resolveFieldFinishFn := handleExtensionsResolveFieldDidStart(eCtx, ctx, &info)
 	if len(extErrs) != 0 {
-		eCtx.Errors = append(eCtx.Errors, extErrs...)
+		eCtx.addErrors(extErrs...)
 	}
 
-	result, resolveFnError = resolveFn(ResolveParams{
-		Source:  source,
-		Args:    args,
-		Info:    info,
-		Context: eCtx.Context,
-	})
+	// the Timeout applies through the context of the resolver, which covers
+	// the thunks it returns as well
+	resolveCtx, cancel := ctx, context.CancelFunc(func() {})
+	if fieldDef.Timeout > 0 {
+		resolveCtx, cancel = context.WithTimeout(ctx, fieldDef.Timeout)
+	}
+	// doneError is the error of resolvers returning once their context is
+	// done, whose late results are discarded: the error of the execution's
+	// context, or the timeout of the field while the execution goes on
+	doneError := func() error {
+		if resolveCtx.Err() == nil {
+			return nil
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		return &fieldTimeoutError{parentType: parentType, fieldName: fieldName, timeout: fieldDef.Timeout}
+	}
+
+	resolve := func() (any, error) {
+		return resolveFn(ResolveParams{
+			Source:  source,
+			Args:    args,
+			Info:    info,
+			Context: resolveCtx,
+		})
+	}
+	// the default resolver reads the source only, it isn't abandoned
+	if fieldDef.Resolve == nil {
+		result, resolveFnError = resolve()
+	} else {
+		result, resolveFnError = callWithContext(resolveCtx, resolve)
+	}
+	if err := doneError(); err != nil {
+		result, resolveFnError = nil, err
+	}
+	var thunk func() (any, error)
+	isThunk := false
+	if fieldDef.Timeout > 0 && resolveFnError == nil {
+		thunk, isThunk = asThunk(result)
+	}
+	if isThunk {
+		// the thunk is never called if the parent is nulled before, so the
+		// timer is stopped once the execution is done at the latest
+		eCtx.addTimeout(cancel)
+		result = func() (any, error) {
+			defer cancel()
+			value, err := callWithContext(resolveCtx, thunk)
+			if doneErr := doneError(); doneErr != nil {
+				return nil, doneErr
+			}
+			return value, err
+		}
+	} else {
+		cancel()
+	}
 
 	extErrs = resolveFieldFinishFn(result, resolveFnError)
 	if len(extErrs) != 0 {
-		eCtx.Errors = append(eCtx.Errors, extErrs...)
+		eCtx.addErrors(extErrs...)
 	}
 
+	// delegated executions return their errors along with their data
+	if errs, ok := resolveFnError.(delegatedErrors); ok {
+		eCtx.addErrors(errs...)
+		resolveFnError = nil
+	}
 	if resolveFnError != nil {
 		panic(resolveFnError)
 	}
 
-	completed := completeValueCatchingError(eCtx, returnType, fieldASTs, info, path, result)
+	completed := completeValueCatchingError(ctx, eCtx, returnType, fieldASTs, info, path, result)
 	return completed, resultState
 }
 
-func completeValueCatchingError(eCtx *executionContext, returnType Type, fieldASTs []*ast.Field, info ResolveInfo, path *ResponsePath, result any) (completed any) {
+func completeValueCatchingError(ctx context.Context, eCtx *executionContext, returnType Type, fieldASTs []*ast.Field, info ResolveInfo, path *ResponsePath, result any) (completed any) {
 	// catch panic
 	defer func() any {
 		if r := recover(); r != nil {
@@ -672,25 +900,25 @@ func completeValueCatchingError(eCtx *executionContext, returnType Type, fieldAS
 	}()
 
 	if returnType, ok := returnType.(*NonNull); ok {
-		completed := completeValue(eCtx, returnType, fieldASTs, info, path, result)
+		completed := completeValue(ctx, eCtx, returnType, fieldASTs, info, path, result)
 		return completed
 	}
-	completed = completeValue(eCtx, returnType, fieldASTs, info, path, result)
+	completed = completeValue(ctx, eCtx, returnType, fieldASTs, info, path, result)
 	return completed
 }
 
-func completeValue(eCtx *executionContext, returnType Type, fieldASTs []*ast.Field, info ResolveInfo, path *ResponsePath, result any) any {
+func completeValue(ctx context.Context, eCtx *executionContext, returnType Type, fieldASTs []*ast.Field, info ResolveInfo, path *ResponsePath, result any) any {
 	resultVal := reflect.ValueOf(result)
 	if resultVal.IsValid() && resultVal.Kind() == reflect.Func {
 		return func() any {
-			return completeThunkValueCatchingError(eCtx, returnType, fieldASTs, info, path, result)
+			return completeThunkValueCatchingError(ctx, eCtx, returnType, fieldASTs, info, path, result)
 		}
 	}
 
 	// If field type is NonNull, complete for inner type, and throw field error
 	// if result is null.
 	if returnType, ok := returnType.(*NonNull); ok {
-		completed := completeValue(eCtx, returnType.OfType, fieldASTs, info, path, result)
+		completed := completeValue(ctx, eCtx, returnType.OfType, fieldASTs, info, path, result)
 		if completed == nil {
 			err := NewLocatedErrorWithPath(
 				fmt.Sprintf("Cannot return null for non-nullable field %v.%v.", info.ParentType, info.FieldName),
@@ -709,7 +937,7 @@ func completeValue(eCtx *executionContext, returnType Type, fieldASTs []*ast.Fie
 
 	// If field type is List, complete each item in the list with the inner type
 	if returnType, ok := returnType.(*List); ok {
-		return completeListValue(eCtx, returnType, fieldASTs, info, path, result)
+		return completeListValue(ctx, eCtx, returnType, fieldASTs, info, path, result)
 	}
 
 	// If field type is a leaf type, Scalar or Enum, serialize to a valid value,
@@ -724,15 +952,15 @@ func completeValue(eCtx *executionContext, returnType Type, fieldASTs []*ast.Fie
 	// If field type is an abstract type, Interface or Union, determine the
 	// runtime Object type and complete for that type.
 	if returnType, ok := returnType.(*Union); ok {
-		return completeAbstractValue(eCtx, returnType, fieldASTs, info, path, result)
+		return completeAbstractValue(ctx, eCtx, returnType, fieldASTs, info, path, result)
 	}
 	if returnType, ok := returnType.(*Interface); ok {
-		return completeAbstractValue(eCtx, returnType, fieldASTs, info, path, result)
+		return completeAbstractValue(ctx, eCtx, returnType, fieldASTs, info, path, result)
 	}
 
 	// If field type is Object, execute and complete all sub-selections.
 	if returnType, ok := returnType.(*Object); ok {
-		return completeObjectValue(eCtx, returnType, fieldASTs, info, path, result)
+		return completeObjectValue(ctx, eCtx, returnType, fieldASTs, info, path, result)
 	}
 
 	// Not reachable. All possible output types have been considered.
@@ -744,7 +972,7 @@ func completeValue(eCtx *executionContext, returnType Type, fieldASTs []*ast.Fie
 	return nil
 }
 
-func completeThunkValueCatchingError(eCtx *executionContext, returnType Type, fieldASTs []*ast.Field, info ResolveInfo, path *ResponsePath, result any) (completed any) {
+func completeThunkValueCatchingError(ctx context.Context, eCtx *executionContext, returnType Type, fieldASTs []*ast.Field, info ResolveInfo, path *ResponsePath, result any) (completed any) {
 	// catch any panic invoked from the propertyFn (thunk)
 	defer func() {
 		if r := recover(); r != nil {
@@ -752,12 +980,20 @@ func completeThunkValueCatchingError(eCtx *executionContext, returnType Type, fi
 		}
 	}()
 
-	propertyFn, ok := result.(func() (any, error))
+	propertyFn, ok := asThunk(result)
 	if !ok {
 		err := gqlerrors.NewFormattedError("Error resolving func. Expected `func() (any, error)` signature")
 		panic(gqlerrors.FormatError(err))
 	}
-	fnResult, err := propertyFn()
+	// thunks are not called once the context is done, and abandoned if it is
+	// done before they return
+	if err := ctx.Err(); err != nil {
+		panic(gqlerrors.FormatError(err))
+	}
+	fnResult, err := callWithContext(ctx, propertyFn)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		err = ctxErr
+	}
 	if err != nil {
 		panic(gqlerrors.FormatError(err))
 	}
@@ -765,23 +1001,108 @@ func completeThunkValueCatchingError(eCtx *executionContext, returnType Type, fi
 	result = fnResult
 
 	if returnType, ok := returnType.(*NonNull); ok {
-		completed := completeValue(eCtx, returnType, fieldASTs, info, path, result)
+		completed := completeValue(ctx, eCtx, returnType, fieldASTs, info, path, result)
 		return completed
 	}
-	completed = completeValue(eCtx, returnType, fieldASTs, info, path, result)
+	completed = completeValue(ctx, eCtx, returnType, fieldASTs, info, path, result)
 
 	return completed
 }
 
+// asThunk returns the thunk returned by a resolver as func() (any, error),
+// adapting thunks returning values of other types than any, like
+// func() (*User, error).
+func asThunk(fn any) (func() (any, error), bool) {
+	if thunk, ok := fn.(func() (any, error)); ok {
+		return thunk, true
+	}
+	fnVal := reflect.ValueOf(fn)
+	if !fnVal.IsValid() {
+		return nil, false
+	}
+	fnType := fnVal.Type()
+	if !isThunkType(fnType) || fnVal.IsNil() {
+		return nil, false
+	}
+	return func() (any, error) {
+		out := fnVal.Call(nil)
+		err, _ := out[1].Interface().(error)
+		return out[0].Interface(), err
+	}, true
+}
+
+// callWithContext calls fn and returns its results, or the error of ctx if ctx
+// is done before fn returns. fn is abandoned then, its results are discarded
+// when it returns.
+func callWithContext(ctx context.Context, fn func() (any, error)) (any, error) {
+	if ctx.Done() == nil {
+		return fn()
+	}
+
+	type fnResult struct {
+		value     any
+		err       error
+		recovered any
+	}
+	done := make(chan fnResult, 1)
+	go func() {
+		var r fnResult
+		defer func() {
+			r.recovered = recover()
+			done <- r
+		}()
+		r.value, r.err = fn()
+	}()
+
+	select {
+	case r := <-done:
+		if r.recovered != nil {
+			panic(r.recovered)
+		}
+		return r.value, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fieldTimeoutError is the error of fields whose resolvers exceeded the
+// Timeout of the field.
+type fieldTimeoutError struct {
+	parentType *Object
+	fieldName  string
+	timeout    time.Duration
+}
+
+func (e *fieldTimeoutError) Error() string {
+	return fmt.Sprintf("%v.%v timed out after %v", e.parentType, e.fieldName, e.timeout)
+}
+
+func (e *fieldTimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// delegatedErrors are the errors of a delegated execution, like the one of a
+// merged root field. The field resolves to the data of the execution
+// nonetheless, and the errors are added at their own paths.
+type delegatedErrors []gqlerrors.FormattedError
+
+func (e delegatedErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Message)
+	}
+	return strings.Join(messages, "\n")
+}
+
 // completeAbstractValue completes value of an Abstract type (Union / Interface) by determining the runtime type
 // of that value, then completing based on that type.
-func completeAbstractValue(eCtx *executionContext, returnType Abstract, fieldASTs []*ast.Field, info ResolveInfo, path *ResponsePath, result any) any {
+func completeAbstractValue(ctx context.Context, eCtx *executionContext, returnType Abstract, fieldASTs []*ast.Field, info ResolveInfo, path *ResponsePath, result any) any {
 	var runtimeType *Object
 
 	resolveTypeParams := ResolveTypeParams{
 		Value:   result,
 		Info:    info,
-		Context: eCtx.Context,
+		Context: ctx,
 	}
 	if unionReturnType, ok := returnType.(*Union); ok && unionReturnType.ResolveType != nil {
 		runtimeType = unionReturnType.ResolveType(resolveTypeParams)
@@ -805,11 +1126,11 @@ func completeAbstractValue(eCtx *executionContext, returnType Abstract, fieldAST
 		))
 	}
 
-	return completeObjectValue(eCtx, runtimeType, fieldASTs, info, path, result)
+	return completeObjectValue(ctx, eCtx, runtimeType, fieldASTs, info, path, result)
 }
 
 // completeObjectValue complete an Object value by executing all sub-selections.
-func completeObjectValue(eCtx *executionContext, returnType *Object, fieldASTs []*ast.Field, info ResolveInfo, path *ResponsePath, result any) any {
+func completeObjectValue(ctx context.Context, eCtx *executionContext, returnType *Object, fieldASTs []*ast.Field, info ResolveInfo, path *ResponsePath, result any) any {
 	// If there is an isTypeOf predicate function, call it with the
 	// current result. If isTypeOf returns false, then raise an error rather
 	// than continuing execution.
@@ -817,7 +1138,7 @@ func completeObjectValue(eCtx *executionContext, returnType *Object, fieldASTs [
 		p := IsTypeOfParams{
 			Value:   result,
 			Info:    info,
-			Context: eCtx.Context,
+			Context: ctx,
 		}
 		if !returnType.IsTypeOf(p) {
 			panic(gqlerrors.NewFormattedError(
@@ -827,7 +1148,7 @@ func completeObjectValue(eCtx *executionContext, returnType *Object, fieldASTs [
 	}
 
 	// Collect sub-fields to execute to complete this value.
-	subFieldASTs := map[string][]*ast.Field{}
+	subFieldASTs := newCollectedFields()
 	visitedFragmentNames := map[string]bool{}
 	for _, fieldAST := range fieldASTs {
 		if fieldAST == nil {
@@ -847,6 +1168,7 @@ func completeObjectValue(eCtx *executionContext, returnType *Object, fieldASTs [
 	}
 	executeFieldsParams := executeFieldsParams{
 		ExecutionContext: eCtx,
+		Context:          ctx,
 		ParentType:       returnType,
 		Source:           result,
 		Fields:           subFieldASTs,
@@ -868,7 +1190,7 @@ func completeLeafValue(returnType Leaf, result any) any {
 }
 
 // completeListValue complete a list value by completing each item in the list with the inner type
-func completeListValue(eCtx *executionContext, returnType *List, fieldASTs []*ast.Field, info ResolveInfo, path *ResponsePath, result any) any {
+func completeListValue(ctx context.Context, eCtx *executionContext, returnType *List, fieldASTs []*ast.Field, info ResolveInfo, path *ResponsePath, result any) any {
 	resultVal := reflect.ValueOf(result)
 	if resultVal.Kind() == reflect.Ptr {
 		resultVal = resultVal.Elem()
@@ -890,7 +1212,14 @@ func completeListValue(eCtx *executionContext, returnType *List, fieldASTs []*as
 	for i := 0; i < resultVal.Len(); i++ {
 		val := resultVal.Index(i).Interface()
 		fieldPath := path.WithKey(i)
-		completedItem := completeValueCatchingError(eCtx, itemType, fieldASTs, info, fieldPath, val)
+		// once the context is done, the first item not completed yet resolves
+		// to its error and the others to null, non-null items null the list
+		if err := ctx.Err(); err != nil {
+			handleFieldError(err, FieldASTsToNodeASTs(fieldASTs), fieldPath, itemType, eCtx)
+			completedResults = append(completedResults, make([]any, resultVal.Len()-i)...)
+			break
+		}
+		completedItem := completeValueCatchingError(ctx, eCtx, itemType, fieldASTs, info, fieldPath, val)
 		completedResults = append(completedResults, completedItem)
 	}
 	return completedResults
@@ -930,6 +1259,12 @@ func DefaultResolveFn(p ResolveParams) (any, error) {
 		return resolver.Resolve(p)
 	}
 
+	// try p.Source as an ordered map, like the data of results
+	if sourceMap, ok := p.Source.(*OrderedMap); ok {
+		property, _ := sourceMap.Get(p.Info.FieldName)
+		return property, nil
+	}
+
 	// try to resolve p.Source as a struct
 	if sourceVal.IsValid() && sourceVal.Type().Kind() == reflect.Ptr {
 		sourceVal = sourceVal.Elem()
@@ -1008,7 +1343,7 @@ func DefaultResolveFn(p ResolveParams) (any, error) {
 // are allowed, like on a Union. __schema could get automatically
 // added to the query type, but that would require mutating type
 // definitions, which would cause issues.
-func getFieldDef(schema Schema, parentType *Object, fieldName string) *FieldDefinition {
+func getFieldDef(ctx context.Context, schema Schema, parentType *Object, fieldName string) *FieldDefinition {
 	if parentType == nil {
 		return nil
 	}
@@ -1024,41 +1359,9 @@ func getFieldDef(schema Schema, parentType *Object, fieldName string) *FieldDefi
 	if fieldName == TypeNameMetaFieldDef.Name {
 		return TypeNameMetaFieldDef
 	}
-	return parentType.Fields()[fieldName]
-}
-
-// contains field information that will be placed in an ordered slice
-type orderedField struct {
-	responseName string
-	fieldASTs    []*ast.Field
-}
-
-// orders fields from a fields map by location in the source
-func orderedFields(fields map[string][]*ast.Field) []*orderedField {
-	orderedFields := []*orderedField{}
-	fieldMap := map[int]*orderedField{}
-	startLocs := []int{}
-
-	for responseName, fieldASTs := range fields {
-		// find the lowest location in the current fieldASTs
-		lowest := -1
-		for _, fieldAST := range fieldASTs {
-			loc := fieldAST.GetLoc().Start
-			if lowest == -1 || loc < lowest {
-				lowest = loc
-			}
-		}
-		startLocs = append(startLocs, lowest)
-		fieldMap[lowest] = &orderedField{
-			responseName: responseName,
-			fieldASTs:    fieldASTs,
-		}
-	}
-
-	sort.Ints(startLocs)
-	for _, startLoc := range startLocs {
-		orderedFields = append(orderedFields, fieldMap[startLoc])
+	fieldDef := parentType.Fields()[fieldName]
+	if !isFieldVisible(ctx, parentType, fieldDef) {
+		return nil
 	}
-
-	return orderedFields
+	return fieldDef
 }
@@ -1833,7 +1833,8 @@ func TestContextDeadline(t *testing.T) {
 	expectedErrors := []gqlerrors.FormattedError{
 		{
 			Message:   context.DeadlineExceeded.Error(),
-			Locations: []location.SourceLocation{},
+			Locations: []location.SourceLocation{{Line: 1, Column: 2}},
+			Path:      []any{"hello"},
 		},
 	}
 
@@ -1878,6 +1879,9 @@ func TestContextDeadline(t *testing.T) {
 	if !testutil.EqualFormattedErrors(expectedErrors, result.Errors) {
 		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expectedErrors, result.Errors))
 	}
+	if !reflect.DeepEqual(map[string]any{"hello": nil}, result.Data) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(map[string]any{"hello": nil}, result.Data))
+	}
 }
 
 func TestThunkResultsProcessedCorrectly(t *testing.T) {
@@ -0,0 +1,303 @@
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/fraym/graphql-go/gqlerrors"
+	"github.com/fraym/graphql-go/language/ast"
+)
+
+// ExtendSchema returns a new Schema with the type definitions, type extensions, schema
+// extensions and directive definitions of the document applied to the given schema.
+// The given schema is left unchanged.
+//
+// Fields, types and enum values of the given schema keep their runtime behaviour.
+// Options wire runtime behaviour into the definitions added by the document; resolvers
+// and abstract type functions may also be given to replace those of the given schema.
+func ExtendSchema(schema Schema, doc *ast.Document, options BuildSchemaOptions) (Schema, error) {
+	if doc == nil {
+		return Schema{}, gqlerrors.NewFormattedError("Must provide valid Document AST")
+	}
+	if schema.QueryType() == nil {
+		return Schema{}, gqlerrors.NewFormattedError("Must provide valid Schema")
+	}
+	return newSchemaBuilder(&schema, options).build(doc)
+}
+
+// extendTypes rebuilds every type of the base schema, except introspection types and
+// specified scalars, into the builder so that type references resolve to the extended types.
+func (b *schemaBuilder) extendTypes() {
+	for name, ttype := range b.base.TypeMap() {
+		if isIntrospectionType(ttype) {
+			continue
+		}
+		switch ttype := ttype.(type) {
+		case *Scalar:
+			if !isSpecifiedScalarType(ttype) {
+				b.types[name] = b.extendScalar(ttype)
+			}
+		case *Object:
+			b.types[name] = b.extendObject(ttype)
+		case *Interface:
+			b.types[name] = b.extendInterface(ttype)
+		case *Union:
+			b.types[name] = b.extendUnion(ttype)
+		case *Enum:
+			b.types[name] = b.extendEnum(ttype)
+		case *InputObject:
+			b.types[name] = b.extendInputObject(ttype)
+		}
+	}
+}
+
+// replaceType returns the type of the extended schema that replaces the given type.
+func (b *schemaBuilder) replaceType(ttype Type) Type {
+	switch ttype := ttype.(type) {
+	case *List:
+		return NewList(b.replaceType(ttype.OfType))
+	case *NonNull:
+		return NewNonNull(b.replaceType(ttype.OfType))
+	}
+	if replaced, ok := b.types[ttype.Name()]; ok {
+		return replaced
+	}
+	return ttype
+}
+
+// extendScalar reuses a custom scalar of the base schema unless its extensions
+// specify its behaviour with @specifiedBy or apply directives to it.
+func (b *schemaBuilder) extendScalar(scalar *Scalar) *Scalar {
+	directives := b.extensionDirectives(scalar.Name(), nil)
+	if len(directives) == 0 {
+		return scalar
+	}
+	config := scalar.scalarConfig
+	if url := specifiedByURL(directives); url != "" {
+		config.SpecifiedByURL = url
+	}
+	config.AppliedDirectives = append(
+		append([]*AppliedDirective{}, config.AppliedDirectives...), b.appliedDirectives(directives)...,
+	)
+	return NewScalar(config)
+}
+
+// extendAppliedDirectives appends the directives applied by the extensions of
+// the named type to those applied to the type of the base schema.
+func (b *schemaBuilder) extendAppliedDirectives(name string, applied []*AppliedDirective) []*AppliedDirective {
+	extended := b.appliedDirectives(b.extensionDirectives(name, nil))
+	if len(extended) == 0 {
+		return applied
+	}
+	return append(append([]*AppliedDirective{}, applied...), extended...)
+}
+
+func (b *schemaBuilder) extendObject(object *Object) *Object {
+	name := object.Name()
+	isTypeOf := object.IsTypeOf
+	if override, ok := b.options.IsTypeOf[name]; ok {
+		isTypeOf = override
+	}
+	return NewObject(ObjectConfig{
+		Name:              name,
+		Description:       object.PrivateDescription,
+		IsTypeOf:          isTypeOf,
+		AppliedDirectives: b.extendAppliedDirectives(name, object.AppliedDirectives()),
+		Interfaces: InterfacesThunk(func() []*Interface {
+			return b.extendInterfaces(name, object.Interfaces())
+		}),
+		Fields: FieldsThunk(func() Fields {
+			return b.extendFields(name, object.Fields())
+		}),
+	})
+}
+
+func (b *schemaBuilder) extendInterface(iface *Interface) *Interface {
+	name := iface.Name()
+	return NewInterface(InterfaceConfig{
+		Name:              name,
+		Description:       iface.Description(),
+		ResolveType:       b.extendResolveType(name, iface.ResolveType),
+		AppliedDirectives: b.extendAppliedDirectives(name, iface.AppliedDirectives()),
+		Interfaces: InterfacesThunk(func() []*Interface {
+			return b.extendInterfaces(name, iface.Interfaces())
+		}),
+		Fields: FieldsThunk(func() Fields {
+			return b.extendFields(name, iface.Fields())
+		}),
+	})
+}
+
+func (b *schemaBuilder) extendInterfaces(typeName string, interfaces []*Interface) []*Interface {
+	ifaces := []*Interface{}
+	for _, iface := range interfaces {
+		ifaces = append(ifaces, b.replaceType(iface).(*Interface))
+	}
+	return append(ifaces, b.buildInterfaces(typeName, b.extensionInterfaces(typeName, nil))...)
+}
+
+func (b *schemaBuilder) extendFields(typeName string, fieldMap FieldDefinitionMap) Fields {
+	fields := Fields{}
+	for fieldName, field := range fieldMap {
+		coordinate := typeName + "." + fieldName
+		resolve := field.Resolve
+		if override, ok := b.options.Resolvers[coordinate]; ok {
+			resolve = override
+		}
+		subscribe := field.Subscribe
+		if override, ok := b.options.Subscribers[coordinate]; ok {
+			subscribe = override
+		}
+		fieldConfig := &Field{
+			Name:              field.Name,
+			Description:       field.Description,
+			Type:              b.replaceType(field.Type),
+			Args:              b.extendArgs(field.Args),
+			Resolve:           resolve,
+			Subscribe:         subscribe,
+			DeprecationReason: field.DeprecationReason,
+			AppliedDirectives: field.AppliedDirectives,
+			IsVisible:         field.IsVisible,
+			Timeout:           field.Timeout,
+		}
+		if fieldConfig = b.transformField(typeName, fieldConfig); fieldConfig != nil {
+			fields[fieldName] = fieldConfig
+		}
+	}
+	defs := []*ast.FieldDefinition{}
+	for _, def := range b.extensionFields(typeName, nil) {
+		if _, ok := fieldMap[def.Name.Value]; ok {
+			b.errs = append(b.errs, newSDLError(fmt.Sprintf(`Field "%v.%v" already exists in the schema. It cannot also be defined in this type extension.`, typeName, def.Name.Value), def.Name))
+			continue
+		}
+		defs = append(defs, def)
+	}
+	return b.addFields(typeName, fields, defs)
+}
+
+func (b *schemaBuilder) extendArgs(args []*Argument) FieldConfigArgument {
+	configs := FieldConfigArgument{}
+	for _, arg := range args {
+		configs = append(configs, &ArgumentConfig{
+			Name:              arg.Name(),
+			Description:       arg.Description(),
+			Type:              b.replaceType(arg.Type),
+			DefaultValue:      arg.DefaultValue,
+			DeprecationReason: arg.DeprecationReason,
+			AppliedDirectives: arg.AppliedDirectives,
+		})
+	}
+	return configs
+}
+
+// extendResolveType maps the types resolved by the ResolveTypeFn of the base schema
+// to the types of the extended schema, unless the options replace the function.
+func (b *schemaBuilder) extendResolveType(name string, resolveType ResolveTypeFn) ResolveTypeFn {
+	if override, ok := b.options.ResolveType[name]; ok {
+		return override
+	}
+	if resolveType == nil {
+		return nil
+	}
+	return func(p ResolveTypeParams) *Object {
+		object := resolveType(p)
+		if object == nil {
+			return nil
+		}
+		if replaced, ok := b.types[object.Name()].(*Object); ok {
+			return replaced
+		}
+		return object
+	}
+}
+
+func (b *schemaBuilder) extendUnion(union *Union) *Union {
+	name := union.Name()
+	return NewUnion(UnionConfig{
+		Name:              name,
+		Description:       union.Description(),
+		ResolveType:       b.extendResolveType(name, union.ResolveType),
+		AppliedDirectives: b.extendAppliedDirectives(name, union.AppliedDirectives()),
+		Types: UnionTypesThunk(func() []*Object {
+			types := []*Object{}
+			for _, member := range union.Types() {
+				types = append(types, b.replaceType(member).(*Object))
+			}
+			return append(types, b.buildUnionMembers(name, b.extensionUnionMembers(name, nil))...)
+		}),
+	})
+}
+
+func (b *schemaBuilder) extendEnum(enum *Enum) *Enum {
+	name := enum.Name()
+	values := EnumValueConfigMap{}
+	for _, value := range enum.Values() {
+		values[value.Name] = &EnumValueConfig{
+			Value:             value.Value,
+			Description:       value.Description,
+			DeprecationReason: value.DeprecationReason,
+			AppliedDirectives: value.AppliedDirectives,
+		}
+	}
+	defs := []*ast.EnumValueDefinition{}
+	for _, def := range b.extensionEnumValues(name, nil) {
+		if _, ok := values[def.Name.Value]; ok {
+			b.errs = append(b.errs, newSDLError(fmt.Sprintf(`Enum value "%v.%v" already exists in the schema. It cannot also be defined in this type extension.`, name, def.Name.Value), def.Name))
+			continue
+		}
+		defs = append(defs, def)
+	}
+	return NewEnum(EnumConfig{
+		Name:              name,
+		Description:       enum.Description(),
+		Values:            b.addEnumValues(name, values, defs),
+		AppliedDirectives: b.extendAppliedDirectives(name, enum.AppliedDirectives()),
+	})
+}
+
+func (b *schemaBuilder) extendInputObject(inputObject *InputObject) *InputObject {
+	name := inputObject.Name()
+	return NewInputObject(InputObjectConfig{
+		Name:              name,
+		Description:       inputObject.Description(),
+		IsOneOf:           inputObject.IsOneOf() || hasDirective(b.extensionDirectives(name, nil), OneOfDirective.Name),
+		AppliedDirectives: b.extendAppliedDirectives(name, inputObject.AppliedDirectives()),
+		Fields: InputObjectConfigFieldMapThunk(func() InputObjectConfigFieldMap {
+			fieldMap := inputObject.Fields()
+			fields := InputObjectConfigFieldMap{}
+			for fieldName, field := range fieldMap {
+				fields[fieldName] = &InputObjectFieldConfig{
+					Type:              b.replaceType(field.Type),
+					Description:       field.Description(),
+					DefaultValue:      field.DefaultValue,
+					DeprecationReason: field.DeprecationReason,
+					AppliedDirectives: field.AppliedDirectives,
+				}
+			}
+			defs := []*ast.InputValueDefinition{}
+			for _, def := range b.extensionInputFields(name, nil) {
+				if _, ok := fieldMap[def.Name.Value]; ok {
+					b.errs = append(b.errs, newSDLError(fmt.Sprintf(`Field "%v.%v" already exists in the schema. It cannot also be defined in this type extension.`, name, def.Name.Value), def.Name))
+					continue
+				}
+				defs = append(defs, def)
+			}
+			return b.addInputFields(name, fields, defs)
+		}),
+	})
+}
+
+// extendDirective rebuilds a custom directive of the base schema so that its
+// arguments refer to the types of the extended schema.
+func (b *schemaBuilder) extendDirective(directive *Directive) *Directive {
+	if isSpecifiedDirective(directive) {
+		return directive
+	}
+	return NewDirective(DirectiveConfig{
+		Name:           directive.Name,
+		Description:    directive.Description,
+		Locations:      directive.Locations,
+		Args:           b.extendArgs(directive.Args),
+		IsRepeatable:   directive.IsRepeatable,
+		TransformField: directive.TransformField,
+	})
+}
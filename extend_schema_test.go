@@ -0,0 +1,401 @@
+package graphql_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/language/ast"
+	"github.com/fraym/graphql-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+const extendSchemaTestSDL = `
+type Query {
+  hello: String
+  pet: Pet
+}
+
+interface Pet {
+  name: String
+}
+
+type Dog implements Pet {
+  name: String
+}
+
+union SearchResult = Dog
+
+enum Size {
+  SMALL
+}
+
+input PetFilter {
+  name: String
+}
+
+scalar Timestamp
+`
+
+func extendSchemaTestBase(t *testing.T) graphql.Schema {
+	schema, err := graphql.BuildSchema(extendSchemaTestSDL, graphql.BuildSchemaOptions{
+		Resolvers: map[string]graphql.FieldResolveFn{
+			"Query.hello": func(p graphql.ResolveParams) (any, error) {
+				return "world", nil
+			},
+			"Query.pet": func(p graphql.ResolveParams) (any, error) {
+				return map[string]any{"__typename": "Dog", "name": "Odie", "size": "SMALL"}, nil
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return schema
+}
+
+func extendTestSchema(t *testing.T, schema graphql.Schema, sdl string, options graphql.BuildSchemaOptions) (graphql.Schema, error) {
+	return graphql.ExtendSchema(schema, testutil.TestParse(t, sdl), options)
+}
+
+func TestExtendSchema_AddsFieldsToQuery(t *testing.T) {
+	base := extendSchemaTestBase(t)
+
+	extended, err := extendTestSchema(t, base, `
+extend type Query {
+  "Counts the pets"
+  petCount(min: Int = 1): Int
+}`, graphql.BuildSchemaOptions{
+		Resolvers: map[string]graphql.FieldResolveFn{
+			"Query.petCount": func(p graphql.ResolveParams) (any, error) {
+				return p.Args["min"], nil
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        extended,
+		RequestString: `{ hello petCount pet { name ... on Dog { name } } }`,
+	})
+	if len(result.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+	expected := map[string]any{
+		"hello":    "world",
+		"petCount": int64(1),
+		"pet":      map[string]any{"name": "Odie"},
+	}
+	if !reflect.DeepEqual(expected, result.Data) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result.Data))
+	}
+	assert.Equal(t, "Counts the pets", extended.QueryType().Fields()["petCount"].Description)
+}
+
+func TestExtendSchema_LeavesOriginalSchemaUnchanged(t *testing.T) {
+	base := extendSchemaTestBase(t)
+	printed := graphql.PrintSchema(base)
+
+	_, err := extendTestSchema(t, base, `
+extend type Query { extra: String }
+extend enum Size { LARGE }
+type Cat implements Pet { name: String }
+`, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assert.Equal(t, printed, graphql.PrintSchema(base))
+	assert.Nil(t, base.QueryType().Fields()["extra"])
+	assert.Nil(t, base.Type("Cat"))
+}
+
+func TestExtendSchema_ExtendsTypesOfAllKinds(t *testing.T) {
+	base := extendSchemaTestBase(t)
+
+	extended, err := extendTestSchema(t, base, `
+directive @tag(name: String!) on FIELD_DEFINITION
+
+interface Named {
+  name: String
+}
+
+type Cat implements Pet {
+  name: String
+  nickname: String
+}
+
+extend type Dog implements Named {
+  nickname: String
+  size: Size
+}
+
+extend interface Pet {
+  nickname: String
+}
+
+extend union SearchResult = Cat
+
+extend enum Size {
+  LARGE
+}
+
+extend input PetFilter {
+  size: Size = LARGE
+}
+
+extend scalar Timestamp @specifiedBy(url: "https://tools.ietf.org/html/rfc3339")
+
+extend schema {
+  mutation: Mutation
+}
+
+type Mutation {
+  adopt(filter: PetFilter): Pet
+}
+`, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `directive @tag(name: String!) on FIELD_DEFINITION
+
+type Cat implements Pet {
+  name: String
+  nickname: String
+}
+
+type Dog implements Pet & Named {
+  name: String
+  nickname: String
+  size: Size
+}
+
+type Mutation {
+  adopt(filter: PetFilter): Pet
+}
+
+interface Named {
+  name: String
+}
+
+interface Pet {
+  name: String
+  nickname: String
+}
+
+input PetFilter {
+  name: String
+  size: Size = LARGE
+}
+
+type Query {
+  hello: String
+  pet: Pet
+}
+
+union SearchResult = Dog | Cat
+
+enum Size {
+  LARGE
+  SMALL
+}
+
+scalar Timestamp @specifiedBy(url: "https://tools.ietf.org/html/rfc3339")`
+	if printed := graphql.PrintSchema(extended); printed != expected {
+		t.Fatalf("Unexpected SDL, Diff: %v", testutil.Diff(expected, printed))
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        extended,
+		RequestString: `{ pet { ... on Dog { size } } }`,
+	})
+	if len(result.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+	assert.Equal(t, map[string]any{"pet": map[string]any{"size": "SMALL"}}, result.Data)
+}
+
+func TestExtendSchema_KeepsResolveTypeOfExtendedTypes(t *testing.T) {
+	var dogType *graphql.Object
+	petType := graphql.NewInterface(graphql.InterfaceConfig{
+		Name: "Pet",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{Type: graphql.String},
+		},
+		ResolveType: func(p graphql.ResolveTypeParams) *graphql.Object {
+			return dogType
+		},
+	})
+	dogType = graphql.NewObject(graphql.ObjectConfig{
+		Name:       "Dog",
+		Interfaces: []*graphql.Interface{petType},
+		Fields: graphql.Fields{
+			"name": &graphql.Field{Type: graphql.String},
+		},
+	})
+	base, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"pet": &graphql.Field{
+					Type: petType,
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return map[string]any{"name": "Odie", "tricks": 3}, nil
+					},
+				},
+			},
+		}),
+		Types: []graphql.Type{dogType},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	extended, err := extendTestSchema(t, base, `extend type Dog { tricks: Int }`, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema:        extended,
+		RequestString: `{ pet { name ... on Dog { tricks } } }`,
+	})
+	if len(result.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+	assert.Equal(t, map[string]any{"pet": map[string]any{"name": "Odie", "tricks": int64(3)}}, result.Data)
+}
+
+func TestExtendSchema_ReplacesResolvers(t *testing.T) {
+	base := extendSchemaTestBase(t)
+
+	extended, err := extendTestSchema(t, base, `extend type Query { other: String }`, graphql.BuildSchemaOptions{
+		Resolvers: map[string]graphql.FieldResolveFn{
+			"Query.hello": func(p graphql.ResolveParams) (any, error) {
+				return "replaced", nil
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result := graphql.Do(graphql.Params{Schema: extended, RequestString: `{ hello }`})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{"hello": "replaced"}, result.Data)
+}
+
+func TestExtendSchema_RejectsInvalidExtensions(t *testing.T) {
+	tests := []struct {
+		name    string
+		sdl     string
+		message string
+	}{
+		{
+			name:    "schema definition",
+			sdl:     `schema { query: Query }`,
+			message: "Cannot define a new schema within a schema extension.",
+		},
+		{
+			name:    "existing type",
+			sdl:     `type Dog { name: String }`,
+			message: `Type "Dog" already exists in the schema. It cannot also be defined in this type definition.`,
+		},
+		{
+			name:    "existing field",
+			sdl:     `extend type Query { hello: String }`,
+			message: `Field "Query.hello" already exists in the schema. It cannot also be defined in this type extension.`,
+		},
+		{
+			name:    "existing enum value",
+			sdl:     `extend enum Size { SMALL }`,
+			message: `Enum value "Size.SMALL" already exists in the schema. It cannot also be defined in this type extension.`,
+		},
+		{
+			name:    "existing input field",
+			sdl:     `extend input PetFilter { name: String }`,
+			message: `Field "PetFilter.name" already exists in the schema. It cannot also be defined in this type extension.`,
+		},
+		{
+			name:    "existing directive",
+			sdl:     `directive @skip(if: Boolean!) on FIELD`,
+			message: `Directive "@skip" already exists in the schema. It cannot be redefined.`,
+		},
+		{
+			name:    "existing root operation",
+			sdl:     `extend schema { query: Dog }`,
+			message: `Must provide only one query type in schema.`,
+		},
+		{
+			name:    "undefined type",
+			sdl:     `extend type Cat { name: String }`,
+			message: `Cannot extend type "Cat" because it is not defined.`,
+		},
+		{
+			name:    "kind mismatch",
+			sdl:     `extend union Pet = Dog`,
+			message: `Cannot extend non-union type "Pet".`,
+		},
+		{
+			name:    "specified scalar",
+			sdl:     `extend scalar String @deprecated`,
+			message: `Cannot extend specified scalar "String".`,
+		},
+		{
+			name:    "unknown type",
+			sdl:     `extend type Query { cat: Cat }`,
+			message: `Unknown type "Cat".`,
+		},
+		{
+			name:    "executable definition",
+			sdl:     `{ hello }`,
+			message: `Cannot extend a schema with a document containing a OperationDefinition`,
+		},
+	}
+	base := extendSchemaTestBase(t)
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := extendTestSchema(t, base, test.sdl, graphql.BuildSchemaOptions{})
+			assert.EqualError(t, err, test.message)
+		})
+	}
+}
+
+func TestExtendSchema_RequiresDocument(t *testing.T) {
+	_, err := graphql.ExtendSchema(extendSchemaTestBase(t), nil, graphql.BuildSchemaOptions{})
+	assert.EqualError(t, err, "Must provide valid Document AST")
+
+	_, err = graphql.ExtendSchema(graphql.Schema{}, &ast.Document{}, graphql.BuildSchemaOptions{})
+	assert.EqualError(t, err, "Must provide valid Schema")
+}
+
+func TestBuildSchema_AppliesExtensionsOfAllKinds(t *testing.T) {
+	schema, err := graphql.BuildSchema(`
+type Query { pet: Pet }
+interface Pet { name: String }
+extend interface Pet { age: Int }
+type Dog implements Pet { name: String age: Int }
+union Result = Dog
+extend union Result = Query
+enum Size { SMALL }
+extend enum Size { LARGE }
+input Filter { size: Size }
+extend input Filter { name: String }
+`, graphql.BuildSchemaOptions{
+		EnumValues: map[string]any{"Size.LARGE": 2},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	pet := schema.Type("Pet").(*graphql.Interface)
+	assert.Contains(t, pet.Fields(), "age")
+	assert.Len(t, schema.Type("Result").(*graphql.Union).Types(), 2)
+	value, err := schema.Type("Size").(*graphql.Enum).ParseValue("LARGE")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, value)
+	assert.Contains(t, schema.Type("Filter").(*graphql.InputObject).Fields(), "name")
+}
@@ -192,14 +192,18 @@ func handleExtensionsExecutionDidStart(p *ExecuteParams) ([]gqlerrors.FormattedE
 }
 
 // handleResolveFieldDidStart handles the notification of the extensions about the start of a resolve function
-func handleExtensionsResolveFieldDidStart(p *executionContext, i *ResolveInfo) ([]gqlerrors.FormattedError, resolveFieldFinishFuncHandler) {
+// and returns the context of the field derived from the context of its parent
+func handleExtensionsResolveFieldDidStart(p *executionContext, ctx context.Context, i *ResolveInfo) (context.Context, []gqlerrors.FormattedError, resolveFieldFinishFuncHandler) {
 	fs := map[string]ResolveFieldFinishFunc{}
 	errs := gqlerrors.FormattedErrors{}
+	if len(p.Schema.extensions) == 0 {
+		return ctx, errs, func(any, error) []gqlerrors.FormattedError {
+			return nil
+		}
+	}
+	p.extensionsMu.Lock()
+	defer p.extensionsMu.Unlock()
 	for _, ext := range p.Schema.extensions {
-		var (
-			ctx      context.Context
-			finishFn ResolveFieldFinishFunc
-		)
 		// catch panic from an extension's resolveFieldDidStart function
 		func() {
 			defer func() {
@@ -207,13 +211,15 @@ func handleExtensionsResolveFieldDidStart(p *executionContext, i *ResolveInfo) (
 					errs = append(errs, gqlerrors.FormatError(fmt.Errorf("%s.ResolveFieldDidStart: %v", ext.Name(), r.(error))))
 				}
 			}()
-			ctx, finishFn = ext.ResolveFieldDidStart(p.Context, i)
+			extCtx, finishFn := ext.ResolveFieldDidStart(ctx, i)
 			// update context
-			p.Context = ctx
+			ctx = extCtx
 			fs[ext.Name()] = finishFn
 		}()
 	}
-	return errs, func(val any, err error) []gqlerrors.FormattedError {
+	return ctx, errs, func(val any, err error) []gqlerrors.FormattedError {
+		p.extensionsMu.Lock()
+		defer p.extensionsMu.Unlock()
 		extErrs := gqlerrors.FormattedErrors{}
 		for name, finishFn := range fs {
 			func() {
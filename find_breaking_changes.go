@@ -0,0 +1,457 @@
+package graphql
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/fraym/graphql-go/language/printer"
+)
+
+// BreakingChangeType identifies the kind of a BreakingChange.
+type BreakingChangeType string
+
+const (
+	BreakingChangeTypeRemoved                 BreakingChangeType = "TYPE_REMOVED"
+	BreakingChangeTypeChangedKind             BreakingChangeType = "TYPE_CHANGED_KIND"
+	BreakingChangeTypeRemovedFromUnion        BreakingChangeType = "TYPE_REMOVED_FROM_UNION"
+	BreakingChangeValueRemovedFromEnum        BreakingChangeType = "VALUE_REMOVED_FROM_ENUM"
+	BreakingChangeRequiredInputFieldAdded     BreakingChangeType = "REQUIRED_INPUT_FIELD_ADDED"
+	BreakingChangeImplementedInterfaceRemoved BreakingChangeType = "IMPLEMENTED_INTERFACE_REMOVED"
+	BreakingChangeFieldRemoved                BreakingChangeType = "FIELD_REMOVED"
+	BreakingChangeFieldChangedKind            BreakingChangeType = "FIELD_CHANGED_KIND"
+	BreakingChangeRequiredArgAdded            BreakingChangeType = "REQUIRED_ARG_ADDED"
+	BreakingChangeArgRemoved                  BreakingChangeType = "ARG_REMOVED"
+	BreakingChangeArgChangedKind              BreakingChangeType = "ARG_CHANGED_KIND"
+	BreakingChangeDirectiveRemoved            BreakingChangeType = "DIRECTIVE_REMOVED"
+	BreakingChangeDirectiveArgRemoved         BreakingChangeType = "DIRECTIVE_ARG_REMOVED"
+	BreakingChangeRequiredDirectiveArgAdded   BreakingChangeType = "REQUIRED_DIRECTIVE_ARG_ADDED"
+	BreakingChangeDirectiveRepeatableRemoved  BreakingChangeType = "DIRECTIVE_REPEATABLE_REMOVED"
+	BreakingChangeDirectiveLocationRemoved    BreakingChangeType = "DIRECTIVE_LOCATION_REMOVED"
+)
+
+// DangerousChangeType identifies the kind of a DangerousChange.
+type DangerousChangeType string
+
+const (
+	DangerousChangeValueAddedToEnum          DangerousChangeType = "VALUE_ADDED_TO_ENUM"
+	DangerousChangeTypeAddedToUnion          DangerousChangeType = "TYPE_ADDED_TO_UNION"
+	DangerousChangeOptionalInputFieldAdded   DangerousChangeType = "OPTIONAL_INPUT_FIELD_ADDED"
+	DangerousChangeOptionalArgAdded          DangerousChangeType = "OPTIONAL_ARG_ADDED"
+	DangerousChangeImplementedInterfaceAdded DangerousChangeType = "IMPLEMENTED_INTERFACE_ADDED"
+	DangerousChangeArgDefaultValueChange     DangerousChangeType = "ARG_DEFAULT_VALUE_CHANGE"
+)
+
+// BreakingChange is a change between two schemas which breaks existing clients,
+// e.g. the removal of a field or a new required argument.
+type BreakingChange struct {
+	Type        BreakingChangeType `json:"type"`
+	Description string             `json:"description"`
+}
+
+// DangerousChange is a change between two schemas which does not break the
+// validation of existing operations but may change their results, e.g. a new
+// enum value a client does not know how to handle.
+type DangerousChange struct {
+	Type        DangerousChangeType `json:"type"`
+	Description string              `json:"description"`
+}
+
+// FindBreakingChanges returns the changes from the old to the new schema which
+// break existing clients.
+func FindBreakingChanges(oldSchema Schema, newSchema Schema) []*BreakingChange {
+	return findSchemaChanges(oldSchema, newSchema).breaking
+}
+
+// FindDangerousChanges returns the changes from the old to the new schema which
+// may change the results of existing operations.
+func FindDangerousChanges(oldSchema Schema, newSchema Schema) []*DangerousChange {
+	return findSchemaChanges(oldSchema, newSchema).dangerous
+}
+
+type schemaChanges struct {
+	breaking  []*BreakingChange
+	dangerous []*DangerousChange
+}
+
+func (c *schemaChanges) addBreaking(changeType BreakingChangeType, format string, a ...any) {
+	c.breaking = append(c.breaking, &BreakingChange{Type: changeType, Description: fmt.Sprintf(format, a...)})
+}
+
+func (c *schemaChanges) addDangerous(changeType DangerousChangeType, format string, a ...any) {
+	c.dangerous = append(c.dangerous, &DangerousChange{Type: changeType, Description: fmt.Sprintf(format, a...)})
+}
+
+func findSchemaChanges(oldSchema Schema, newSchema Schema) *schemaChanges {
+	changes := &schemaChanges{
+		breaking:  []*BreakingChange{},
+		dangerous: []*DangerousChange{},
+	}
+	changes.findTypeChanges(oldSchema, newSchema)
+	changes.findDirectiveChanges(oldSchema, newSchema)
+	return changes
+}
+
+func (c *schemaChanges) findDirectiveChanges(oldSchema Schema, newSchema Schema) {
+	for _, oldDirective := range oldSchema.Directives() {
+		newDirective := newSchema.Directive(oldDirective.Name)
+		if newDirective == nil {
+			c.addBreaking(BreakingChangeDirectiveRemoved, "%v was removed.", oldDirective.Name)
+			continue
+		}
+
+		for _, newArg := range newDirective.Args {
+			if findArgument(oldDirective.Args, newArg.Name()) == nil && isRequiredInput(newArg.Type, newArg.DefaultValue) {
+				c.addBreaking(BreakingChangeRequiredDirectiveArgAdded,
+					"A required arg %v on directive %v was added.", newArg.Name(), oldDirective.Name)
+			}
+		}
+		for _, oldArg := range oldDirective.Args {
+			if findArgument(newDirective.Args, oldArg.Name()) == nil {
+				c.addBreaking(BreakingChangeDirectiveArgRemoved,
+					"%v was removed from %v.", oldArg.Name(), oldDirective.Name)
+			}
+		}
+		if oldDirective.IsRepeatable && !newDirective.IsRepeatable {
+			c.addBreaking(BreakingChangeDirectiveRepeatableRemoved,
+				"Repeatable flag was removed from %v.", oldDirective.Name)
+		}
+		for _, location := range oldDirective.Locations {
+			if !containsString(newDirective.Locations, location) {
+				c.addBreaking(BreakingChangeDirectiveLocationRemoved,
+					"%v was removed from %v.", location, oldDirective.Name)
+			}
+		}
+	}
+}
+
+func (c *schemaChanges) findTypeChanges(oldSchema Schema, newSchema Schema) {
+	oldTypeMap := oldSchema.TypeMap()
+	newTypeMap := newSchema.TypeMap()
+	for _, typeName := range sortedTypeNames(oldTypeMap) {
+		oldType := oldTypeMap[typeName]
+		newType, ok := newTypeMap[typeName]
+		if !ok {
+			if isSpecifiedScalarType(oldType) {
+				c.addBreaking(BreakingChangeTypeRemoved,
+					"Standard scalar %v was removed because it is not referenced anymore.", typeName)
+			} else {
+				c.addBreaking(BreakingChangeTypeRemoved, "%v was removed.", typeName)
+			}
+			continue
+		}
+
+		switch oldType := oldType.(type) {
+		case *Enum:
+			if newType, ok := newType.(*Enum); ok {
+				c.findEnumTypeChanges(oldType, newType)
+				continue
+			}
+		case *Union:
+			if newType, ok := newType.(*Union); ok {
+				c.findUnionTypeChanges(oldType, newType)
+				continue
+			}
+		case *InputObject:
+			if newType, ok := newType.(*InputObject); ok {
+				c.findInputObjectTypeChanges(oldType, newType)
+				continue
+			}
+		case *Object:
+			if newType, ok := newType.(*Object); ok {
+				c.findFieldChanges(oldType, oldType.Fields(), newType.Fields())
+				c.findImplementedInterfacesChanges(oldType, oldType.Interfaces(), newType.Interfaces())
+				continue
+			}
+		case *Interface:
+			if newType, ok := newType.(*Interface); ok {
+				c.findFieldChanges(oldType, oldType.Fields(), newType.Fields())
+				c.findImplementedInterfacesChanges(oldType, oldType.Interfaces(), newType.Interfaces())
+				continue
+			}
+		}
+		if oldKind, newKind := typeKindName(oldType), typeKindName(newType); oldKind != newKind {
+			c.addBreaking(BreakingChangeTypeChangedKind,
+				"%v changed from %v to %v.", typeName, oldKind, newKind)
+		}
+	}
+}
+
+func (c *schemaChanges) findInputObjectTypeChanges(oldType *InputObject, newType *InputObject) {
+	oldFields := oldType.Fields()
+	newFields := newType.Fields()
+	for _, fieldName := range sortedInputFieldNames(newFields) {
+		if _, ok := oldFields[fieldName]; ok {
+			continue
+		}
+		newField := newFields[fieldName]
+		if isRequiredInput(newField.Type, newField.DefaultValue) {
+			c.addBreaking(BreakingChangeRequiredInputFieldAdded,
+				"A required field %v on input type %v was added.", fieldName, oldType.Name())
+		} else {
+			c.addDangerous(DangerousChangeOptionalInputFieldAdded,
+				"An optional field %v on input type %v was added.", fieldName, oldType.Name())
+		}
+	}
+	for _, fieldName := range sortedInputFieldNames(oldFields) {
+		oldField := oldFields[fieldName]
+		newField, ok := newFields[fieldName]
+		if !ok {
+			c.addBreaking(BreakingChangeFieldRemoved, "%v.%v was removed.", oldType.Name(), fieldName)
+			continue
+		}
+		if !isChangeSafeForInputValue(oldField.Type, newField.Type) {
+			c.addBreaking(BreakingChangeFieldChangedKind,
+				"%v.%v changed type from %v to %v.", oldType.Name(), fieldName, oldField.Type, newField.Type)
+		}
+	}
+}
+
+func (c *schemaChanges) findUnionTypeChanges(oldType *Union, newType *Union) {
+	for _, newMember := range newType.Types() {
+		if !containsObject(oldType.Types(), newMember.Name()) {
+			c.addDangerous(DangerousChangeTypeAddedToUnion,
+				"%v was added to union type %v.", newMember.Name(), oldType.Name())
+		}
+	}
+	for _, oldMember := range oldType.Types() {
+		if !containsObject(newType.Types(), oldMember.Name()) {
+			c.addBreaking(BreakingChangeTypeRemovedFromUnion,
+				"%v was removed from union type %v.", oldMember.Name(), oldType.Name())
+		}
+	}
+}
+
+func (c *schemaChanges) findEnumTypeChanges(oldType *Enum, newType *Enum) {
+	oldValues := oldType.getNameLookup()
+	newValues := newType.getNameLookup()
+	for _, value := range sortedEnumValueNames(newValues) {
+		if _, ok := oldValues[value]; !ok {
+			c.addDangerous(DangerousChangeValueAddedToEnum,
+				"%v was added to enum type %v.", value, oldType.Name())
+		}
+	}
+	for _, value := range sortedEnumValueNames(oldValues) {
+		if _, ok := newValues[value]; !ok {
+			c.addBreaking(BreakingChangeValueRemovedFromEnum,
+				"%v was removed from enum type %v.", value, oldType.Name())
+		}
+	}
+}
+
+func (c *schemaChanges) findImplementedInterfacesChanges(oldType Named, oldInterfaces []*Interface, newInterfaces []*Interface) {
+	for _, newInterface := range newInterfaces {
+		if !containsInterface(oldInterfaces, newInterface.Name()) {
+			c.addDangerous(DangerousChangeImplementedInterfaceAdded,
+				"%v added to interfaces implemented by %v.", newInterface.Name(), oldType)
+		}
+	}
+	for _, oldInterface := range oldInterfaces {
+		if !containsInterface(newInterfaces, oldInterface.Name()) {
+			c.addBreaking(BreakingChangeImplementedInterfaceRemoved,
+				"%v no longer implements interface %v.", oldType, oldInterface.Name())
+		}
+	}
+}
+
+func (c *schemaChanges) findFieldChanges(oldType Named, oldFields FieldDefinitionMap, newFields FieldDefinitionMap) {
+	fieldNames := make([]string, 0, len(oldFields))
+	for fieldName := range oldFields {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+	for _, fieldName := range fieldNames {
+		oldField := oldFields[fieldName]
+		newField, ok := newFields[fieldName]
+		if !ok {
+			c.addBreaking(BreakingChangeFieldRemoved, "%v.%v was removed.", oldType, fieldName)
+			continue
+		}
+		c.findArgChanges(oldType, oldField, newField)
+		if !isChangeSafeForOutputField(oldField.Type, newField.Type) {
+			c.addBreaking(BreakingChangeFieldChangedKind,
+				"%v.%v changed type from %v to %v.", oldType, fieldName, oldField.Type, newField.Type)
+		}
+	}
+}
+
+func (c *schemaChanges) findArgChanges(oldType Named, oldField *FieldDefinition, newField *FieldDefinition) {
+	for _, oldArg := range oldField.Args {
+		newArg := findArgument(newField.Args, oldArg.Name())
+		if newArg == nil {
+			c.addBreaking(BreakingChangeArgRemoved,
+				"%v.%v arg %v was removed.", oldType, oldField.Name, oldArg.Name())
+			continue
+		}
+		if !isChangeSafeForInputValue(oldArg.Type, newArg.Type) {
+			c.addBreaking(BreakingChangeArgChangedKind,
+				"%v.%v arg %v has changed type from %v to %v.",
+				oldType, oldField.Name, oldArg.Name(), oldArg.Type, newArg.Type)
+			continue
+		}
+		if oldArg.DefaultValue == nil {
+			continue
+		}
+		if newArg.DefaultValue == nil {
+			c.addDangerous(DangerousChangeArgDefaultValueChange,
+				"%v.%v arg %v defaultValue was removed.", oldType, oldField.Name, oldArg.Name())
+			continue
+		}
+		oldValue := printDefaultValue(oldArg.DefaultValue, oldArg.Type)
+		newValue := printDefaultValue(newArg.DefaultValue, newArg.Type)
+		if oldValue != newValue {
+			c.addDangerous(DangerousChangeArgDefaultValueChange,
+				"%v.%v arg %v has changed defaultValue from %v to %v.",
+				oldType, oldField.Name, oldArg.Name(), oldValue, newValue)
+		}
+	}
+	for _, newArg := range newField.Args {
+		if findArgument(oldField.Args, newArg.Name()) != nil {
+			continue
+		}
+		if isRequiredInput(newArg.Type, newArg.DefaultValue) {
+			c.addBreaking(BreakingChangeRequiredArgAdded,
+				"A required arg %v on %v.%v was added.", newArg.Name(), oldType, oldField.Name)
+		} else {
+			c.addDangerous(DangerousChangeOptionalArgAdded,
+				"An optional arg %v on %v.%v was added.", newArg.Name(), oldType, oldField.Name)
+		}
+	}
+}
+
+// isChangeSafeForOutputField reports whether clients selecting a field of the
+// old type can handle values of the new type, i.e. the new type is the same or
+// only adds non-null wrappers.
+func isChangeSafeForOutputField(oldType Type, newType Type) bool {
+	switch oldType := oldType.(type) {
+	case *List:
+		if newType, ok := newType.(*List); ok {
+			return isChangeSafeForOutputField(oldType.OfType, newType.OfType)
+		}
+		if newType, ok := newType.(*NonNull); ok {
+			return isChangeSafeForOutputField(oldType, newType.OfType)
+		}
+		return false
+	case *NonNull:
+		if newType, ok := newType.(*NonNull); ok {
+			return isChangeSafeForOutputField(oldType.OfType, newType.OfType)
+		}
+		return false
+	}
+	if newType, ok := newType.(*NonNull); ok {
+		return isChangeSafeForOutputField(oldType, newType.OfType)
+	}
+	_, isList := newType.(*List)
+	return !isList && oldType.Name() == newType.Name()
+}
+
+// isChangeSafeForInputValue reports whether values clients provide for an argument
+// or input field of the old type are valid for the new type, i.e. the new type is
+// the same or only removes non-null wrappers.
+func isChangeSafeForInputValue(oldType Type, newType Type) bool {
+	switch oldType := oldType.(type) {
+	case *List:
+		if newType, ok := newType.(*List); ok {
+			return isChangeSafeForInputValue(oldType.OfType, newType.OfType)
+		}
+		return false
+	case *NonNull:
+		if newType, ok := newType.(*NonNull); ok {
+			return isChangeSafeForInputValue(oldType.OfType, newType.OfType)
+		}
+		return isChangeSafeForInputValue(oldType.OfType, newType)
+	}
+	switch newType.(type) {
+	case *List, *NonNull:
+		return false
+	}
+	return oldType.Name() == newType.Name()
+}
+
+func typeKindName(ttype Type) string {
+	switch ttype.(type) {
+	case *Scalar:
+		return "a Scalar type"
+	case *Object:
+		return "an Object type"
+	case *Interface:
+		return "an Interface type"
+	case *Union:
+		return "a Union type"
+	case *Enum:
+		return "an Enum type"
+	case *InputObject:
+		return "an Input type"
+	}
+	return fmt.Sprintf("%T", ttype)
+}
+
+func printDefaultValue(value any, ttype Input) string {
+	valueAST := astFromValue(value, ttype)
+	if valueAST == nil {
+		return "null"
+	}
+	return fmt.Sprintf("%v", printer.Print(valueAST))
+}
+
+func findArgument(args []*Argument, name string) *Argument {
+	for _, arg := range args {
+		if arg.Name() == name {
+			return arg
+		}
+	}
+	return nil
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsObject(objects []*Object, name string) bool {
+	for _, object := range objects {
+		if object.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInterface(interfaces []*Interface, name string) bool {
+	for _, iface := range interfaces {
+		if iface.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedTypeNames(typeMap TypeMap) []string {
+	names := make([]string, 0, len(typeMap))
+	for name := range typeMap {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedInputFieldNames(fields InputObjectFieldMap) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedEnumValueNames(values map[string]*EnumValueDefinition) []string {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
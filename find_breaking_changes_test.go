@@ -0,0 +1,171 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildChangesTestSchema(t *testing.T, sdl string) graphql.Schema {
+	schema, err := graphql.BuildSchema(sdl, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return schema
+}
+
+func TestFindBreakingChanges_IgnoresIdenticalSchemas(t *testing.T) {
+	sdl := `
+		directive @cache(ttl: Int) repeatable on FIELD_DEFINITION
+		interface Node { id: ID! }
+		type User implements Node { id: ID! name(format: String = "short"): String }
+		union Result = User
+		enum Role { ADMIN USER }
+		input Filter { role: Role }
+		type Query { users(filter: Filter): [Result] }
+	`
+	oldSchema := buildChangesTestSchema(t, sdl)
+	newSchema := buildChangesTestSchema(t, sdl)
+	assert.Empty(t, graphql.FindBreakingChanges(oldSchema, newSchema))
+	assert.Empty(t, graphql.FindDangerousChanges(oldSchema, newSchema))
+}
+
+func TestFindBreakingChanges_DetectsRemovedAndChangedTypes(t *testing.T) {
+	oldSchema := buildChangesTestSchema(t, `
+		type Type1 { field1: String }
+		type Type2 { field1: String }
+		type Query { field1: Type1 field2: Type2 field3: Float }
+	`)
+	newSchema := buildChangesTestSchema(t, `
+		interface Type1 { field1: String }
+		type Query { field1: Type1 }
+	`)
+	assert.Equal(t, []*graphql.BreakingChange{
+		{Type: graphql.BreakingChangeTypeRemoved, Description: "Standard scalar Float was removed because it is not referenced anymore."},
+		{Type: graphql.BreakingChangeFieldRemoved, Description: "Query.field2 was removed."},
+		{Type: graphql.BreakingChangeFieldRemoved, Description: "Query.field3 was removed."},
+		{Type: graphql.BreakingChangeTypeChangedKind, Description: "Type1 changed from an Object type to an Interface type."},
+		{Type: graphql.BreakingChangeTypeRemoved, Description: "Type2 was removed."},
+	}, graphql.FindBreakingChanges(oldSchema, newSchema))
+}
+
+func TestFindBreakingChanges_DetectsFieldAndArgumentChanges(t *testing.T) {
+	oldSchema := buildChangesTestSchema(t, `
+		type Query {
+			nullable: String
+			nonNull: String!
+			list: [String]
+			changed: String
+			field(
+				removed: String
+				loosened: Int!
+				tightened: Int
+				changed: Int
+				defaulted: Int = 1
+				default: Int = 1
+			): String
+		}
+	`)
+	newSchema := buildChangesTestSchema(t, `
+		type Query {
+			nullable: String!
+			nonNull: String
+			list: [String!]!
+			changed: Int
+			field(
+				loosened: Int
+				tightened: Int!
+				changed: String
+				defaulted: Int
+				default: Int = 2
+				required: String!
+				optional: String
+			): String
+		}
+	`)
+	assert.Equal(t, []*graphql.BreakingChange{
+		{Type: graphql.BreakingChangeFieldChangedKind, Description: "Query.changed changed type from String to Int."},
+		{Type: graphql.BreakingChangeArgRemoved, Description: "Query.field arg removed was removed."},
+		{Type: graphql.BreakingChangeArgChangedKind, Description: "Query.field arg tightened has changed type from Int to Int!."},
+		{Type: graphql.BreakingChangeArgChangedKind, Description: "Query.field arg changed has changed type from Int to String."},
+		{Type: graphql.BreakingChangeRequiredArgAdded, Description: "A required arg required on Query.field was added."},
+		{Type: graphql.BreakingChangeFieldChangedKind, Description: "Query.nonNull changed type from String! to String."},
+	}, graphql.FindBreakingChanges(oldSchema, newSchema))
+	assert.Equal(t, []*graphql.DangerousChange{
+		{Type: graphql.DangerousChangeArgDefaultValueChange, Description: "Query.field arg defaulted defaultValue was removed."},
+		{Type: graphql.DangerousChangeArgDefaultValueChange, Description: "Query.field arg default has changed defaultValue from 1 to 2."},
+		{Type: graphql.DangerousChangeOptionalArgAdded, Description: "An optional arg optional on Query.field was added."},
+	}, graphql.FindDangerousChanges(oldSchema, newSchema))
+}
+
+func TestFindBreakingChanges_DetectsInputObjectChanges(t *testing.T) {
+	oldSchema := buildChangesTestSchema(t, `
+		input Filter { removed: String loosened: Int! tightened: Int }
+		type Query { field(filter: Filter): String }
+	`)
+	newSchema := buildChangesTestSchema(t, `
+		input Filter { loosened: Int tightened: Int! required: String! defaulted: String! = "a" optional: String }
+		type Query { field(filter: Filter): String }
+	`)
+	assert.Equal(t, []*graphql.BreakingChange{
+		{Type: graphql.BreakingChangeRequiredInputFieldAdded, Description: "A required field required on input type Filter was added."},
+		{Type: graphql.BreakingChangeFieldRemoved, Description: "Filter.removed was removed."},
+		{Type: graphql.BreakingChangeFieldChangedKind, Description: "Filter.tightened changed type from Int to Int!."},
+	}, graphql.FindBreakingChanges(oldSchema, newSchema))
+	assert.Equal(t, []*graphql.DangerousChange{
+		{Type: graphql.DangerousChangeOptionalInputFieldAdded, Description: "An optional field defaulted on input type Filter was added."},
+		{Type: graphql.DangerousChangeOptionalInputFieldAdded, Description: "An optional field optional on input type Filter was added."},
+	}, graphql.FindDangerousChanges(oldSchema, newSchema))
+}
+
+func TestFindBreakingChanges_DetectsEnumUnionAndInterfaceChanges(t *testing.T) {
+	oldSchema := buildChangesTestSchema(t, `
+		interface Node { id: ID! }
+		interface Named { name: String }
+		type User implements Node { id: ID! name: String }
+		type Bot { id: ID! name: String }
+		union Actor = User | Bot
+		enum Role { ADMIN USER }
+		type Query { actor(role: Role): Actor node: Node named: Named }
+	`)
+	newSchema := buildChangesTestSchema(t, `
+		interface Node { id: ID! }
+		interface Named { name: String }
+		type User implements Named { id: ID! name: String }
+		type Bot { id: ID! name: String }
+		type Team { id: ID! }
+		union Actor = User | Team
+		enum Role { ADMIN GUEST }
+		type Query { actor(role: Role): Actor node: Node named: Named bot: Bot }
+	`)
+	assert.Equal(t, []*graphql.BreakingChange{
+		{Type: graphql.BreakingChangeTypeRemovedFromUnion, Description: "Bot was removed from union type Actor."},
+		{Type: graphql.BreakingChangeValueRemovedFromEnum, Description: "USER was removed from enum type Role."},
+		{Type: graphql.BreakingChangeImplementedInterfaceRemoved, Description: "User no longer implements interface Node."},
+	}, graphql.FindBreakingChanges(oldSchema, newSchema))
+	assert.Equal(t, []*graphql.DangerousChange{
+		{Type: graphql.DangerousChangeTypeAddedToUnion, Description: "Team was added to union type Actor."},
+		{Type: graphql.DangerousChangeValueAddedToEnum, Description: "GUEST was added to enum type Role."},
+		{Type: graphql.DangerousChangeImplementedInterfaceAdded, Description: "Named added to interfaces implemented by User."},
+	}, graphql.FindDangerousChanges(oldSchema, newSchema))
+}
+
+func TestFindBreakingChanges_DetectsDirectiveChanges(t *testing.T) {
+	oldSchema := buildChangesTestSchema(t, `
+		directive @removed on FIELD
+		directive @cache(ttl: Int, scope: String) repeatable on FIELD | QUERY
+		type Query { field: String }
+	`)
+	newSchema := buildChangesTestSchema(t, `
+		directive @cache(ttl: Int, maxAge: Int!) on FIELD
+		type Query { field: String }
+	`)
+	assert.Equal(t, []*graphql.BreakingChange{
+		{Type: graphql.BreakingChangeDirectiveRemoved, Description: "removed was removed."},
+		{Type: graphql.BreakingChangeRequiredDirectiveArgAdded, Description: "A required arg maxAge on directive cache was added."},
+		{Type: graphql.BreakingChangeDirectiveArgRemoved, Description: "scope was removed from cache."},
+		{Type: graphql.BreakingChangeDirectiveRepeatableRemoved, Description: "Repeatable flag was removed from cache."},
+		{Type: graphql.BreakingChangeDirectiveLocationRemoved, Description: "QUERY was removed from cache."},
+	}, graphql.FindBreakingChanges(oldSchema, newSchema))
+}
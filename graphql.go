@@ -29,8 +29,22 @@ type Params struct {
 	OperationName string
 
 	// Context may be provided to pass application-specific per-request
-	// information to resolve functions.
+	// information to resolve functions. Once it is done, the execution stops
+	// resolving fields, see ExecuteParams.Context.
 	Context context.Context
+
+	// MaxConcurrency is the maximum number of field resolvers of query
+	// operations running at the same time, see ExecuteParams.MaxConcurrency.
+	MaxConcurrency int
+
+	// OrderedData returns the data of the result as an *OrderedMap keeping
+	// the order of the selection set, see ExecuteParams.OrderedData.
+	OrderedData bool
+
+	// WarningRules are validation rules, such as NoDeprecatedCustomRule, whose
+	// errors do not prevent execution. They are reported as "warnings" in
+	// Result.Extensions.
+	WarningRules []ValidationRuleFn
 }
 
 func Do(p Params) *Result {
@@ -84,7 +98,7 @@ func Do(p Params) *Result {
 	}
 
 	// validate document
-	validationResult := ValidateDocument(&p.Schema, AST, nil)
+	validationResult := ValidateDocumentWithContext(p.Context, &p.Schema, AST, nil)
 
 	if !validationResult.IsValid {
 		// run validation finish functions for extensions
@@ -105,12 +119,26 @@ func Do(p Params) *Result {
 		}
 	}
 
-	return Execute(ExecuteParams{
-		Schema:        p.Schema,
-		Root:          p.RootObject,
-		AST:           AST,
-		OperationName: p.OperationName,
-		Args:          p.VariableValues,
-		Context:       p.Context,
+	var warnings []gqlerrors.FormattedError
+	if len(p.WarningRules) != 0 {
+		warnings = ValidateDocumentWithContext(p.Context, &p.Schema, AST, p.WarningRules).Errors
+	}
+
+	result := Execute(ExecuteParams{
+		Schema:         p.Schema,
+		Root:           p.RootObject,
+		AST:            AST,
+		OperationName:  p.OperationName,
+		Args:           p.VariableValues,
+		Context:        p.Context,
+		MaxConcurrency: p.MaxConcurrency,
+		OrderedData:    p.OrderedData,
 	})
+	if len(warnings) != 0 {
+		if result.Extensions == nil {
+			result.Extensions = make(map[string]any)
+		}
+		result.Extensions["warnings"] = warnings
+	}
+	return result
 }
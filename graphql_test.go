@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/gqlerrors"
 	"github.com/fraym/graphql-go/testutil"
 )
 
@@ -274,3 +275,37 @@ func TestEmptyStringIsNotNull(t *testing.T) {
 		t.Errorf("wrong result, query: %v, graphql result diff: %v", query, testutil.Diff(expected, result))
 	}
 }
+
+func TestDo_ReportsWarningRulesInExtensions(t *testing.T) {
+	schema := noDeprecatedTestSchema(t)
+	query := `{ oldPet pet }`
+
+	result := graphql.Do(graphql.Params{
+		Schema:        *schema,
+		RequestString: query,
+		WarningRules:  []graphql.ValidationRuleFn{graphql.NoDeprecatedCustomRule},
+	})
+	if len(result.Errors) > 0 {
+		t.Fatalf("wrong result, unexpected errors: %v", result.Errors)
+	}
+	expected := map[string]any{"oldPet": nil, "pet": nil}
+	if !reflect.DeepEqual(result.Data, expected) {
+		t.Fatalf("wrong result, query: %v, graphql result diff: %v", query, testutil.Diff(expected, result.Data))
+	}
+	warnings, _ := result.Extensions["warnings"].([]gqlerrors.FormattedError)
+	if len(warnings) != 1 {
+		t.Fatalf("expected one warning, got: %v", result.Extensions)
+	}
+	expectedWarning := testutil.RuleError(`The field Query.oldPet is deprecated. Use pet.`, 1, 3)
+	if warnings[0].Message != expectedWarning.Message || !reflect.DeepEqual(warnings[0].Locations, expectedWarning.Locations) {
+		t.Fatalf("wrong warning, diff: %v", testutil.Diff(expectedWarning, warnings[0]))
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:        *schema,
+		RequestString: query,
+	})
+	if result.Extensions != nil {
+		t.Fatalf("expected no extensions without warning rules, got: %v", result.Extensions)
+	}
+}
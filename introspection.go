@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strconv"
 
 	"github.com/fraym/graphql-go/language/ast"
 	"github.com/fraym/graphql-go/language/printer"
@@ -225,6 +226,24 @@ func init() {
 			"description": &Field{
 				Type: String,
 			},
+			"specifiedByURL": &Field{
+				Type: String,
+				Resolve: func(p ResolveParams) (any, error) {
+					if ttype, ok := p.Source.(*Scalar); ok && ttype.SpecifiedByURL() != "" {
+						return ttype.SpecifiedByURL(), nil
+					}
+					return nil, nil
+				},
+			},
+			"isOneOf": &Field{
+				Type: Boolean,
+				Resolve: func(p ResolveParams) (any, error) {
+					if ttype, ok := p.Source.(*InputObject); ok {
+						return ttype.IsOneOf(), nil
+					}
+					return nil, nil
+				},
+			},
 			"fields":        &Field{},
 			"interfaces":    &Field{},
 			"possibleTypes": &Field{},
@@ -261,19 +280,34 @@ func init() {
 						if isNullish(inputVal.DefaultValue) {
 							return nil, nil
 						}
-						astVal := astFromValue(inputVal.DefaultValue, inputVal)
+						astVal := astFromValue(inputVal.DefaultValue, inputVal.Type)
 						return printer.Print(astVal), nil
 					}
 					if inputVal, ok := p.Source.(*InputObjectField); ok {
 						if inputVal.DefaultValue == nil {
 							return nil, nil
 						}
-						astVal := astFromValue(inputVal.DefaultValue, inputVal)
+						astVal := astFromValue(inputVal.DefaultValue, inputVal.Type)
 						return printer.Print(astVal), nil
 					}
 					return nil, nil
 				},
 			},
+			"isDeprecated": &Field{
+				Type: NewNonNull(Boolean),
+				Resolve: func(p ResolveParams) (any, error) {
+					return inputValueDeprecationReason(p.Source) != "", nil
+				},
+			},
+			"deprecationReason": &Field{
+				Type: String,
+				Resolve: func(p ResolveParams) (any, error) {
+					if reason := inputValueDeprecationReason(p.Source); reason != "" {
+						return reason, nil
+					}
+					return nil, nil
+				},
+			},
 		},
 	})
 
@@ -290,9 +324,17 @@ func init() {
 			},
 			"args": &Field{
 				Type: NewNonNull(NewList(NewNonNull(InputValueType))),
+				Args: FieldConfigArgument{
+					&ArgumentConfig{
+						Name:         "includeDeprecated",
+						Type:         Boolean,
+						DefaultValue: false,
+					},
+				},
 				Resolve: func(p ResolveParams) (any, error) {
 					if field, ok := p.Source.(*FieldDefinition); ok {
-						return field.Args, nil
+						includeDeprecated, _ := p.Args["includeDeprecated"].(bool)
+						return filterDeprecatedArgs(field.Args, includeDeprecated), nil
 					}
 					return []any{}, nil
 				},
@@ -347,6 +389,23 @@ func init() {
 				Type: NewNonNull(NewList(
 					NewNonNull(InputValueType),
 				)),
+				Args: FieldConfigArgument{
+					&ArgumentConfig{
+						Name:         "includeDeprecated",
+						Type:         Boolean,
+						DefaultValue: false,
+					},
+				},
+				Resolve: func(p ResolveParams) (any, error) {
+					if dir, ok := p.Source.(*Directive); ok {
+						includeDeprecated, _ := p.Args["includeDeprecated"].(bool)
+						return filterDeprecatedArgs(dir.Args, includeDeprecated), nil
+					}
+					return []any{}, nil
+				},
+			},
+			"isRepeatable": &Field{
+				Type: NewNonNull(Boolean),
 			},
 			// NOTE: the following three fields are deprecated and are no longer part
 			// of the GraphQL specification.
@@ -540,6 +599,9 @@ func init() {
 					if !includeDeprecated && field.DeprecationReason != "" {
 						continue
 					}
+					if !isFieldVisible(p.Context, ttype, field) {
+						continue
+					}
 					fieldNames = append(fieldNames, name)
 				}
 				sort.Sort(fieldNames)
@@ -556,6 +618,9 @@ func init() {
 					if !includeDeprecated && field.DeprecationReason != "" {
 						continue
 					}
+					if !isFieldVisible(p.Context, ttype, field) {
+						continue
+					}
 					fields = append(fields, field)
 				}
 				return fields, nil
@@ -566,7 +631,10 @@ func init() {
 	TypeType.AddFieldConfig("interfaces", &Field{
 		Type: NewList(NewNonNull(TypeType)),
 		Resolve: func(p ResolveParams) (any, error) {
-			if ttype, ok := p.Source.(*Object); ok {
+			switch ttype := p.Source.(type) {
+			case *Object:
+				return ttype.Interfaces(), nil
+			case *Interface:
 				return ttype.Interfaces(), nil
 			}
 			return nil, nil
@@ -613,10 +681,21 @@ func init() {
 	})
 	TypeType.AddFieldConfig("inputFields", &Field{
 		Type: NewList(NewNonNull(InputValueType)),
+		Args: FieldConfigArgument{
+			&ArgumentConfig{
+				Name:         "includeDeprecated",
+				Type:         Boolean,
+				DefaultValue: false,
+			},
+		},
 		Resolve: func(p ResolveParams) (any, error) {
+			includeDeprecated, _ := p.Args["includeDeprecated"].(bool)
 			if ttype, ok := p.Source.(*InputObject); ok {
 				fields := []*InputObjectField{}
 				for _, field := range ttype.Fields() {
+					if !includeDeprecated && field.DeprecationReason != "" {
+						continue
+					}
 					fields = append(fields, field)
 				}
 				return fields, nil
@@ -676,6 +755,32 @@ func init() {
 	}
 }
 
+// filterDeprecatedArgs returns the given arguments, without the deprecated ones
+// unless includeDeprecated is set.
+func filterDeprecatedArgs(args []*Argument, includeDeprecated bool) []*Argument {
+	if includeDeprecated {
+		return args
+	}
+	filtered := []*Argument{}
+	for _, arg := range args {
+		if arg.DeprecationReason == "" {
+			filtered = append(filtered, arg)
+		}
+	}
+	return filtered
+}
+
+// inputValueDeprecationReason returns the deprecation reason of an argument or input field.
+func inputValueDeprecationReason(source any) string {
+	switch inputVal := source.(type) {
+	case *Argument:
+		return inputVal.DeprecationReason
+	case *InputObjectField:
+		return inputVal.DeprecationReason
+	}
+	return ""
+}
+
 // Produces a GraphQL Value AST given a Golang value.
 //
 // Optionally, a GraphQL type may be provided, which will be used to
@@ -734,44 +839,116 @@ func astFromValue(value any, ttype Type) ast.Value {
 		return val
 	}
 
-	// TODO: implement astFromValue from Map to Value
-	// if valueVal.Type().Kind() == reflect.Map {
-	// }
-
-	if value, ok := value.(bool); ok {
-		return ast.NewBooleanValue(&ast.BooleanValue{
-			Value: value,
+	// Populate the fields of the input object by creating ASTs from each value
+	// in the Golang map according to the fields in the input type.
+	if ttype, ok := ttype.(*InputObject); ok {
+		if valueVal.Type().Kind() != reflect.Map || valueVal.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+		fields := ttype.Fields()
+		fieldNames := make([]string, 0, len(fields))
+		for fieldName := range fields {
+			fieldNames = append(fieldNames, fieldName)
+		}
+		sort.Strings(fieldNames)
+		fieldASTs := []*ast.ObjectField{}
+		for _, fieldName := range fieldNames {
+			fieldVal := valueVal.MapIndex(reflect.ValueOf(fieldName).Convert(valueVal.Type().Key()))
+			if !fieldVal.IsValid() {
+				continue
+			}
+			fieldValue := astFromValue(fieldVal.Interface(), fields[fieldName].Type)
+			if fieldValue == nil {
+				continue
+			}
+			fieldASTs = append(fieldASTs, ast.NewObjectField(&ast.ObjectField{
+				Name:  ast.NewName(&ast.Name{Value: fieldName}),
+				Value: fieldValue,
+			}))
+		}
+		return ast.NewObjectValue(&ast.ObjectValue{
+			Fields: fieldASTs,
 		})
 	}
-	if value, ok := value.(int); ok {
-		if ttype == Float {
-			return ast.NewIntValue(&ast.IntValue{
-				Value: fmt.Sprintf("%v.0", value),
+
+	// Leaf types serialize the internal value before it is turned into a literal.
+	if ttype, ok := ttype.(Leaf); ok {
+		serialized, err := ttype.Serialize(value)
+		if err != nil || isNullish(serialized) {
+			return nil
+		}
+		if _, ok := ttype.(*Enum); ok {
+			return ast.NewEnumValue(&ast.EnumValue{
+				Value: fmt.Sprintf("%v", serialized),
 			})
 		}
+		if serialized, ok := serialized.(string); ok && ttype == ID {
+			if _, err := strconv.ParseInt(serialized, 10, 64); err == nil {
+				return ast.NewIntValue(&ast.IntValue{
+					Value: serialized,
+				})
+			}
+		}
+		return astFromUntypedValue(serialized)
+	}
+
+	return astFromUntypedValue(value)
+}
+
+// astFromUntypedValue produces a literal from a Golang value without knowing its GraphQL type.
+func astFromUntypedValue(value any) ast.Value {
+	if isNullish(value) {
+		return ast.NewNullValue(&ast.NullValue{})
+	}
+	valueVal := reflect.ValueOf(value)
+	if valueVal.Type().Kind() == reflect.Ptr {
+		valueVal = valueVal.Elem()
+	}
+
+	switch valueVal.Type().Kind() {
+	case reflect.Bool:
+		return ast.NewBooleanValue(&ast.BooleanValue{
+			Value: valueVal.Bool(),
+		})
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		return ast.NewIntValue(&ast.IntValue{
-			Value: fmt.Sprintf("%v", value),
+			Value: strconv.FormatInt(valueVal.Int(), 10),
 		})
-	}
-	if value, ok := value.(float32); ok {
-		return ast.NewFloatValue(&ast.FloatValue{
-			Value: fmt.Sprintf("%v", value),
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return ast.NewIntValue(&ast.IntValue{
+			Value: strconv.FormatUint(valueVal.Uint(), 10),
 		})
-	}
-	if value, ok := value.(float64); ok {
+	case reflect.Float32, reflect.Float64:
 		return ast.NewFloatValue(&ast.FloatValue{
-			Value: fmt.Sprintf("%v", value),
+			Value: strconv.FormatFloat(valueVal.Float(), 'g', -1, 64),
 		})
-	}
-
-	if value, ok := value.(string); ok {
-		if _, ok := ttype.(*Enum); ok {
-			return ast.NewEnumValue(&ast.EnumValue{
-				Value: fmt.Sprintf("%v", value),
-			})
+	case reflect.Slice, reflect.Array:
+		values := []ast.Value{}
+		for i := 0; i < valueVal.Len(); i++ {
+			values = append(values, astFromUntypedValue(valueVal.Index(i).Interface()))
+		}
+		return ast.NewListValue(&ast.ListValue{
+			Values: values,
+		})
+	case reflect.Map:
+		if valueVal.Type().Key().Kind() != reflect.String {
+			break
+		}
+		keys := []string{}
+		for _, key := range valueVal.MapKeys() {
+			keys = append(keys, key.String())
+		}
+		sort.Strings(keys)
+		fieldASTs := []*ast.ObjectField{}
+		for _, key := range keys {
+			fieldVal := valueVal.MapIndex(reflect.ValueOf(key).Convert(valueVal.Type().Key()))
+			fieldASTs = append(fieldASTs, ast.NewObjectField(&ast.ObjectField{
+				Name:  ast.NewName(&ast.Name{Value: key}),
+				Value: astFromUntypedValue(fieldVal.Interface()),
+			}))
 		}
-		return ast.NewStringValue(&ast.StringValue{
-			Value: fmt.Sprintf("%v", value),
+		return ast.NewObjectValue(&ast.ObjectValue{
+			Fields: fieldASTs,
 		})
 	}
 
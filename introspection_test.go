@@ -7,6 +7,7 @@ import (
 	"github.com/fraym/graphql-go/gqlerrors"
 	"github.com/fraym/graphql-go/language/location"
 	"github.com/fraym/graphql-go/testutil"
+	"github.com/stretchr/testify/assert"
 )
 
 func g(p graphql.Params) *graphql.Result {
@@ -172,6 +173,28 @@ func TestIntrospection_ExecutesAnIntrospectionQuery(t *testing.T) {
 							"isDeprecated":      false,
 							"deprecationReason": nil,
 						},
+						map[string]any{
+							"name": "specifiedByURL",
+							"args": []any{},
+							"type": map[string]any{
+								"kind":   "SCALAR",
+								"name":   "String",
+								"ofType": nil,
+							},
+							"isDeprecated":      false,
+							"deprecationReason": nil,
+						},
+						map[string]any{
+							"name": "isOneOf",
+							"args": []any{},
+							"type": map[string]any{
+								"kind":   "SCALAR",
+								"name":   "Boolean",
+								"ofType": nil,
+							},
+							"isDeprecated":      false,
+							"deprecationReason": nil,
+						},
 						map[string]any{
 							"name": "fields",
 							"args": []any{
@@ -270,7 +293,17 @@ func TestIntrospection_ExecutesAnIntrospectionQuery(t *testing.T) {
 						},
 						map[string]any{
 							"name": "inputFields",
-							"args": []any{},
+							"args": []any{
+								map[string]any{
+									"name": "includeDeprecated",
+									"type": map[string]any{
+										"kind":   "SCALAR",
+										"name":   "Boolean",
+										"ofType": nil,
+									},
+									"defaultValue": "false",
+								},
+							},
 							"type": map[string]any{
 								"kind": "LIST",
 								"name": nil,
@@ -404,7 +437,17 @@ func TestIntrospection_ExecutesAnIntrospectionQuery(t *testing.T) {
 						},
 						map[string]any{
 							"name": "args",
-							"args": []any{},
+							"args": []any{
+								map[string]any{
+									"name": "includeDeprecated",
+									"type": map[string]any{
+										"kind":   "SCALAR",
+										"name":   "Boolean",
+										"ofType": nil,
+									},
+									"defaultValue": "false",
+								},
+							},
 							"type": map[string]any{
 								"kind": "NON_NULL",
 								"name": nil,
@@ -527,6 +570,32 @@ func TestIntrospection_ExecutesAnIntrospectionQuery(t *testing.T) {
 							"isDeprecated":      false,
 							"deprecationReason": nil,
 						},
+						map[string]any{
+							"name": "isDeprecated",
+							"args": []any{},
+							"type": map[string]any{
+								"kind": "NON_NULL",
+								"name": nil,
+								"ofType": map[string]any{
+									"kind":   "SCALAR",
+									"name":   "Boolean",
+									"ofType": nil,
+								},
+							},
+							"isDeprecated":      false,
+							"deprecationReason": nil,
+						},
+						map[string]any{
+							"name": "deprecationReason",
+							"args": []any{},
+							"type": map[string]any{
+								"kind":   "SCALAR",
+								"name":   "String",
+								"ofType": nil,
+							},
+							"isDeprecated":      false,
+							"deprecationReason": nil,
+						},
 					},
 					"inputFields":   nil,
 					"interfaces":    []any{},
@@ -649,7 +718,17 @@ func TestIntrospection_ExecutesAnIntrospectionQuery(t *testing.T) {
 						},
 						map[string]any{
 							"name": "args",
-							"args": []any{},
+							"args": []any{
+								map[string]any{
+									"name": "includeDeprecated",
+									"type": map[string]any{
+										"kind":   "SCALAR",
+										"name":   "Boolean",
+										"ofType": nil,
+									},
+									"defaultValue": "false",
+								},
+							},
 							"type": map[string]any{
 								"kind": "NON_NULL",
 								"name": nil,
@@ -669,6 +748,21 @@ func TestIntrospection_ExecutesAnIntrospectionQuery(t *testing.T) {
 							"isDeprecated":      false,
 							"deprecationReason": nil,
 						},
+						map[string]any{
+							"name": "isRepeatable",
+							"args": []any{},
+							"type": map[string]any{
+								"kind": "NON_NULL",
+								"name": nil,
+								"ofType": map[string]any{
+									"kind":   "SCALAR",
+									"name":   "Boolean",
+									"ofType": nil,
+								},
+							},
+							"isDeprecated":      false,
+							"deprecationReason": nil,
+						},
 						map[string]any{
 							"name": "onOperation",
 							"args": []any{},
@@ -789,6 +883,7 @@ func TestIntrospection_ExecutesAnIntrospectionQuery(t *testing.T) {
 							},
 						},
 					},
+					"isRepeatable": false,
 					// deprecated, but included for coverage till removed
 					"onOperation": false,
 					"onFragment":  true,
@@ -816,6 +911,7 @@ func TestIntrospection_ExecutesAnIntrospectionQuery(t *testing.T) {
 							},
 						},
 					},
+					"isRepeatable": false,
 					// deprecated, but included for coverage till removed
 					"onOperation": false,
 					"onFragment":  true,
@@ -1275,6 +1371,86 @@ func TestIntrospection_RespectsTheIncludeDeprecatedParameterForEnumValues(t *tes
 	}
 }
 
+func TestIntrospection_RespectsTheIncludeDeprecatedParameterForArgsAndInputFields(t *testing.T) {
+	testInput := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "TestInput",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"nonDeprecated": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"deprecated": &graphql.InputObjectFieldConfig{
+				Type:              graphql.String,
+				DeprecationReason: "Removed in 1.0",
+			},
+		},
+	})
+	testType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "TestType",
+		Fields: graphql.Fields{
+			"testField": &graphql.Field{
+				Type: graphql.String,
+				Args: graphql.FieldConfigArgument{
+					&graphql.ArgumentConfig{
+						Name: "nonDeprecated",
+						Type: testInput,
+					},
+					&graphql.ArgumentConfig{
+						Name:              "deprecated",
+						Type:              graphql.String,
+						DeprecationReason: "Removed in 1.0",
+					},
+				},
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: testType,
+	})
+	if err != nil {
+		t.Fatalf("Error creating Schema: %v", err.Error())
+	}
+	query := `
+      {
+        testType: __type(name: "TestType") {
+          fields {
+            trueArgs: args(includeDeprecated: true) { name isDeprecated deprecationReason }
+            falseArgs: args(includeDeprecated: false) { name }
+            omittedArgs: args { name }
+          }
+        }
+        testInput: __type(name: "TestInput") {
+          trueFields: inputFields(includeDeprecated: true) { name }
+          falseFields: inputFields(includeDeprecated: false) { name }
+          omittedFields: inputFields { name isDeprecated deprecationReason }
+        }
+      }
+    `
+	result := g(graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+	})
+	if len(result.Errors) > 0 {
+		t.Fatalf("unexpected errors: %v", result.Errors)
+	}
+	data := result.Data.(map[string]any)
+	field := data["testType"].(map[string]any)["fields"].([]any)[0].(map[string]any)
+	input := data["testInput"].(map[string]any)
+	assert.Equal(t, []any{
+		map[string]any{"name": "nonDeprecated", "isDeprecated": false, "deprecationReason": nil},
+		map[string]any{"name": "deprecated", "isDeprecated": true, "deprecationReason": "Removed in 1.0"},
+	}, field["trueArgs"])
+	assert.Equal(t, []any{map[string]any{"name": "nonDeprecated"}}, field["falseArgs"])
+	assert.Equal(t, []any{map[string]any{"name": "nonDeprecated"}}, field["omittedArgs"])
+	assert.ElementsMatch(t, []any{
+		map[string]any{"name": "nonDeprecated"},
+		map[string]any{"name": "deprecated"},
+	}, input["trueFields"])
+	assert.Equal(t, []any{map[string]any{"name": "nonDeprecated"}}, input["falseFields"])
+	assert.Equal(t, []any{
+		map[string]any{"name": "nonDeprecated", "isDeprecated": false, "deprecationReason": nil},
+	}, input["omittedFields"])
+}
+
 func TestIntrospection_FailsAsExpectedOnThe__TypeRootFieldWithoutAnArg(t *testing.T) {
 	testType := graphql.NewObject(graphql.ObjectConfig{
 		Name: "TestType",
@@ -1466,3 +1642,85 @@ func TestIntrospection_ExposesDescriptionsOnEnums(t *testing.T) {
 		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
 	}
 }
+
+func TestIntrospection_PrintsDefaultValuesAccordingToTheirType(t *testing.T) {
+	colorType := graphql.NewEnum(graphql.EnumConfig{
+		Name: "Color",
+		Values: graphql.EnumValueConfigMap{
+			"RED":  &graphql.EnumValueConfig{Value: 0},
+			"BLUE": &graphql.EnumValueConfig{Value: 1},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "QueryRoot",
+			Fields: graphql.Fields{
+				"field": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						{Name: "int", Type: graphql.Int, DefaultValue: int64(10)},
+						{Name: "color", Type: colorType, DefaultValue: 1},
+						{Name: "colors", Type: graphql.NewList(colorType), DefaultValue: []any{0, 1}},
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error creating Schema: %v", err.Error())
+	}
+	result := g(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ __type(name: "QueryRoot") { fields { args { name defaultValue } } } }`,
+	})
+	expected := &graphql.Result{
+		Data: map[string]any{
+			"__type": map[string]any{
+				"fields": []any{
+					map[string]any{
+						"args": []any{
+							map[string]any{"name": "int", "defaultValue": "10"},
+							map[string]any{"name": "color", "defaultValue": "BLUE"},
+							map[string]any{"name": "colors", "defaultValue": "[RED, BLUE]"},
+						},
+					},
+				},
+			},
+		},
+	}
+	if !testutil.ContainSubset(result.Data.(map[string]any), expected.Data.(map[string]any)) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
+
+func TestIntrospection_ExposesSpecifiedByURLOfScalars(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "QueryRoot",
+			Fields: graphql.Fields{
+				"now": &graphql.Field{Type: graphql.DateTime},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("Error creating Schema: %v", err.Error())
+	}
+	result := g(graphql.Params{
+		Schema: schema,
+		RequestString: `{
+			dateTime: __type(name: "DateTime") { specifiedByURL }
+			string: __type(name: "String") { specifiedByURL }
+			query: __type(name: "QueryRoot") { specifiedByURL }
+		}`,
+	})
+	expected := &graphql.Result{
+		Data: map[string]any{
+			"dateTime": map[string]any{"specifiedByURL": "https://scalars.graphql.org/andimarek/date-time"},
+			"string":   map[string]any{"specifiedByURL": nil},
+			"query":    map[string]any{"specifiedByURL": nil},
+		},
+	}
+	if !testutil.ContainSubset(result.Data.(map[string]any), expected.Data.(map[string]any)) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
@@ -44,5 +44,11 @@ var (
 	_ Node = (*EnumValueDefinition)(nil)
 	_ Node = (*InputObjectDefinition)(nil)
 	_ Node = (*TypeExtensionDefinition)(nil)
+	_ Node = (*ScalarExtensionDefinition)(nil)
+	_ Node = (*InterfaceExtensionDefinition)(nil)
+	_ Node = (*UnionExtensionDefinition)(nil)
+	_ Node = (*EnumExtensionDefinition)(nil)
+	_ Node = (*InputObjectExtensionDefinition)(nil)
+	_ Node = (*SchemaExtensionDefinition)(nil)
 	_ Node = (*DirectiveDefinition)(nil)
 )
@@ -39,6 +39,12 @@ var (
 	_ TypeSystemDefinition = (*SchemaDefinition)(nil)
 	_ TypeSystemDefinition = (TypeDefinition)(nil)
 	_ TypeSystemDefinition = (*TypeExtensionDefinition)(nil)
+	_ TypeSystemDefinition = (*ScalarExtensionDefinition)(nil)
+	_ TypeSystemDefinition = (*InterfaceExtensionDefinition)(nil)
+	_ TypeSystemDefinition = (*UnionExtensionDefinition)(nil)
+	_ TypeSystemDefinition = (*EnumExtensionDefinition)(nil)
+	_ TypeSystemDefinition = (*InputObjectExtensionDefinition)(nil)
+	_ TypeSystemDefinition = (*SchemaExtensionDefinition)(nil)
 	_ TypeSystemDefinition = (*DirectiveDefinition)(nil)
 )
 
@@ -296,6 +302,7 @@ type InterfaceDefinition struct {
 	Loc         *Location
 	Name        *Name
 	Description *StringValue
+	Interfaces  []*Named
 	Directives  []*Directive
 	Fields      []*FieldDefinition
 }
@@ -309,6 +316,7 @@ func NewInterfaceDefinition(def *InterfaceDefinition) *InterfaceDefinition {
 		Loc:         def.Loc,
 		Name:        def.Name,
 		Description: def.Description,
+		Interfaces:  def.Interfaces,
 		Directives:  def.Directives,
 		Fields:      def.Fields,
 	}
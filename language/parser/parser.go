@@ -959,7 +959,7 @@ func parseScalarTypeDefinition(parser *Parser) (ast.Node, error) {
 /**
  * ObjectTypeDefinition :
  *   Description?
- *   type Name ImplementsInterfaces? Directives? { FieldDefinition+ }
+ *   type Name ImplementsInterfaces? Directives? FieldsDefinition?
  */
 func parseObjectTypeDefinition(parser *Parser) (ast.Node, error) {
 	start := parser.Token.Start
@@ -983,19 +983,10 @@ func parseObjectTypeDefinition(parser *Parser) (ast.Node, error) {
 	if err != nil {
 		return nil, err
 	}
-	iFields, err := reverse(parser,
-		lexer.BRACE_L, parseFieldDefinition, lexer.BRACE_R,
-		false,
-	)
+	fields, err := parseFieldsDefinition(parser)
 	if err != nil {
 		return nil, err
 	}
-	fields := []*ast.FieldDefinition{}
-	for _, iField := range iFields {
-		if iField != nil {
-			fields = append(fields, iField.(*ast.FieldDefinition))
-		}
-	}
 	return ast.NewObjectDefinition(&ast.ObjectDefinition{
 		Name:        name,
 		Description: description,
@@ -1035,6 +1026,29 @@ func parseImplementsInterfaces(parser *Parser) ([]*ast.Named, error) {
 	return types, nil
 }
 
+/**
+ * FieldsDefinition : { FieldDefinition+ }
+ */
+func parseFieldsDefinition(parser *Parser) ([]*ast.FieldDefinition, error) {
+	fields := []*ast.FieldDefinition{}
+	if !peek(parser, lexer.BRACE_L) {
+		return fields, nil
+	}
+	iFields, err := reverse(parser,
+		lexer.BRACE_L, parseFieldDefinition, lexer.BRACE_R,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	for _, iField := range iFields {
+		if iField != nil {
+			fields = append(fields, iField.(*ast.FieldDefinition))
+		}
+	}
+	return fields, nil
+}
+
 /**
  * FieldDefinition : Description? Name ArgumentsDefinition? : Type Directives?
  */
@@ -1150,7 +1164,7 @@ func parseInputValueDef(parser *Parser) (any, error) {
 /**
  * InterfaceTypeDefinition :
  *   Description?
- *   interface Name Directives? { FieldDefinition+ }
+ *   interface Name ImplementsInterfaces? Directives? FieldsDefinition?
  */
 func parseInterfaceTypeDefinition(parser *Parser) (ast.Node, error) {
 	start := parser.Token.Start
@@ -1166,26 +1180,22 @@ func parseInterfaceTypeDefinition(parser *Parser) (ast.Node, error) {
 	if err != nil {
 		return nil, err
 	}
-	directives, err := parseDirectives(parser)
+	interfaces, err := parseImplementsInterfaces(parser)
 	if err != nil {
 		return nil, err
 	}
-	iFields, err := reverse(parser,
-		lexer.BRACE_L, parseFieldDefinition, lexer.BRACE_R,
-		false,
-	)
+	directives, err := parseDirectives(parser)
 	if err != nil {
 		return nil, err
 	}
-	fields := []*ast.FieldDefinition{}
-	for _, iField := range iFields {
-		if iField != nil {
-			fields = append(fields, iField.(*ast.FieldDefinition))
-		}
+	fields, err := parseFieldsDefinition(parser)
+	if err != nil {
+		return nil, err
 	}
 	return ast.NewInterfaceDefinition(&ast.InterfaceDefinition{
 		Name:        name,
 		Description: description,
+		Interfaces:  interfaces,
 		Directives:  directives,
 		Loc:         loc(parser, start),
 		Fields:      fields,
@@ -1193,7 +1203,9 @@ func parseInterfaceTypeDefinition(parser *Parser) (ast.Node, error) {
 }
 
 /**
- * UnionTypeDefinition : Description? union Name Directives? = UnionMembers
+ * UnionTypeDefinition : Description? union Name Directives? UnionMemberTypes?
+ *
+ * UnionMemberTypes : = UnionMembers
  */
 func parseUnionTypeDefinition(parser *Parser) (ast.Node, error) {
 	start := parser.Token.Start
@@ -1213,13 +1225,13 @@ func parseUnionTypeDefinition(parser *Parser) (ast.Node, error) {
 	if err != nil {
 		return nil, err
 	}
-	_, err = expect(parser, lexer.EQUALS)
-	if err != nil {
-		return nil, err
-	}
-	types, err := parseUnionMembers(parser)
-	if err != nil {
+	types := []*ast.Named{}
+	if skp, err := skip(parser, lexer.EQUALS); err != nil {
 		return nil, err
+	} else if skp {
+		if types, err = parseUnionMembers(parser); err != nil {
+			return nil, err
+		}
 	}
 	return ast.NewUnionDefinition(&ast.UnionDefinition{
 		Name:        name,
@@ -1253,7 +1265,9 @@ func parseUnionMembers(parser *Parser) ([]*ast.Named, error) {
 }
 
 /**
- * EnumTypeDefinition : Description? enum Name Directives? { EnumValueDefinition+ }
+ * EnumTypeDefinition : Description? enum Name Directives? EnumValuesDefinition?
+ *
+ * EnumValuesDefinition : { EnumValueDefinition+ }
  */
 func parseEnumTypeDefinition(parser *Parser) (ast.Node, error) {
 	start := parser.Token.Start
@@ -1273,17 +1287,19 @@ func parseEnumTypeDefinition(parser *Parser) (ast.Node, error) {
 	if err != nil {
 		return nil, err
 	}
-	iEnumValueDefs, err := reverse(parser,
-		lexer.BRACE_L, parseEnumValueDefinition, lexer.BRACE_R,
-		false,
-	)
-	if err != nil {
-		return nil, err
-	}
 	values := []*ast.EnumValueDefinition{}
-	for _, iEnumValueDef := range iEnumValueDefs {
-		if iEnumValueDef != nil {
-			values = append(values, iEnumValueDef.(*ast.EnumValueDefinition))
+	if peek(parser, lexer.BRACE_L) {
+		iEnumValueDefs, err := reverse(parser,
+			lexer.BRACE_L, parseEnumValueDefinition, lexer.BRACE_R,
+			false,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, iEnumValueDef := range iEnumValueDefs {
+			if iEnumValueDef != nil {
+				values = append(values, iEnumValueDef.(*ast.EnumValueDefinition))
+			}
 		}
 	}
 	return ast.NewEnumDefinition(&ast.EnumDefinition{
@@ -1324,7 +1340,9 @@ func parseEnumValueDefinition(parser *Parser) (any, error) {
 
 /**
  * InputObjectTypeDefinition :
- *   - Description? input Name Directives? { InputValueDefinition+ }
+ *   - Description? input Name Directives? InputFieldsDefinition?
+ *
+ * InputFieldsDefinition : { InputValueDefinition+ }
  */
 func parseInputObjectTypeDefinition(parser *Parser) (ast.Node, error) {
 	start := parser.Token.Start
@@ -1344,17 +1362,19 @@ func parseInputObjectTypeDefinition(parser *Parser) (ast.Node, error) {
 	if err != nil {
 		return nil, err
 	}
-	iInputValueDefinitions, err := reverse(parser,
-		lexer.BRACE_L, parseInputValueDef, lexer.BRACE_R,
-		false,
-	)
-	if err != nil {
-		return nil, err
-	}
 	fields := []*ast.InputValueDefinition{}
-	for _, iInputValueDefinition := range iInputValueDefinitions {
-		if iInputValueDefinition != nil {
-			fields = append(fields, iInputValueDefinition.(*ast.InputValueDefinition))
+	if peek(parser, lexer.BRACE_L) {
+		iInputValueDefinitions, err := reverse(parser,
+			lexer.BRACE_L, parseInputValueDef, lexer.BRACE_R,
+			false,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, iInputValueDefinition := range iInputValueDefinitions {
+			if iInputValueDefinition != nil {
+				fields = append(fields, iInputValueDefinition.(*ast.InputValueDefinition))
+			}
 		}
 	}
 	return ast.NewInputObjectDefinition(&ast.InputObjectDefinition{
@@ -1367,7 +1387,19 @@ func parseInputObjectTypeDefinition(parser *Parser) (ast.Node, error) {
 }
 
 /**
- * TypeExtensionDefinition : extend ObjectTypeDefinition
+ * Extensions must add at least one directive, interface, member, value, field
+ * or operation type to the definition they extend.
+ *
+ * TypeExtension :
+ *   - ObjectTypeExtension
+ *   - InterfaceTypeExtension
+ *   - UnionTypeExtension
+ *   - EnumTypeExtension
+ *   - InputObjectTypeExtension
+ *   - ScalarTypeExtension
+ *   - SchemaExtension
+ *
+ * ObjectTypeExtension : extend ObjectTypeDefinition
  */
 func parseTypeExtensionDefinition(parser *Parser) (ast.Node, error) {
 	start := parser.Token.Start
@@ -1376,19 +1408,142 @@ func parseTypeExtensionDefinition(parser *Parser) (ast.Node, error) {
 		return nil, err
 	}
 
-	definition, err := parseObjectTypeDefinition(parser)
+	switch parser.Token.Value {
+	case lexer.TYPE:
+		node, err := parseObjectTypeDefinition(parser)
+		if err != nil {
+			return nil, err
+		}
+		definition := node.(*ast.ObjectDefinition)
+		if len(definition.Interfaces) == 0 && len(definition.Directives) == 0 && len(definition.Fields) == 0 {
+			return nil, unexpected(parser, lexer.Token{})
+		}
+		return ast.NewTypeExtensionDefinition(&ast.TypeExtensionDefinition{
+			Loc:        loc(parser, start),
+			Definition: definition,
+		}), nil
+	case lexer.INTERFACE:
+		node, err := parseInterfaceTypeDefinition(parser)
+		if err != nil {
+			return nil, err
+		}
+		definition := node.(*ast.InterfaceDefinition)
+		if len(definition.Interfaces) == 0 && len(definition.Directives) == 0 && len(definition.Fields) == 0 {
+			return nil, unexpected(parser, lexer.Token{})
+		}
+		return ast.NewInterfaceExtensionDefinition(&ast.InterfaceExtensionDefinition{
+			Loc:        loc(parser, start),
+			Definition: definition,
+		}), nil
+	case lexer.UNION:
+		node, err := parseUnionTypeDefinition(parser)
+		if err != nil {
+			return nil, err
+		}
+		definition := node.(*ast.UnionDefinition)
+		if len(definition.Directives) == 0 && len(definition.Types) == 0 {
+			return nil, unexpected(parser, lexer.Token{})
+		}
+		return ast.NewUnionExtensionDefinition(&ast.UnionExtensionDefinition{
+			Loc:        loc(parser, start),
+			Definition: definition,
+		}), nil
+	case lexer.ENUM:
+		node, err := parseEnumTypeDefinition(parser)
+		if err != nil {
+			return nil, err
+		}
+		definition := node.(*ast.EnumDefinition)
+		if len(definition.Directives) == 0 && len(definition.Values) == 0 {
+			return nil, unexpected(parser, lexer.Token{})
+		}
+		return ast.NewEnumExtensionDefinition(&ast.EnumExtensionDefinition{
+			Loc:        loc(parser, start),
+			Definition: definition,
+		}), nil
+	case lexer.INPUT:
+		node, err := parseInputObjectTypeDefinition(parser)
+		if err != nil {
+			return nil, err
+		}
+		definition := node.(*ast.InputObjectDefinition)
+		if len(definition.Directives) == 0 && len(definition.Fields) == 0 {
+			return nil, unexpected(parser, lexer.Token{})
+		}
+		return ast.NewInputObjectExtensionDefinition(&ast.InputObjectExtensionDefinition{
+			Loc:        loc(parser, start),
+			Definition: definition,
+		}), nil
+	case lexer.SCALAR:
+		node, err := parseScalarTypeDefinition(parser)
+		if err != nil {
+			return nil, err
+		}
+		definition := node.(*ast.ScalarDefinition)
+		if len(definition.Directives) == 0 {
+			return nil, unexpected(parser, lexer.Token{})
+		}
+		return ast.NewScalarExtensionDefinition(&ast.ScalarExtensionDefinition{
+			Loc:        loc(parser, start),
+			Definition: definition,
+		}), nil
+	case lexer.SCHEMA:
+		definition, err := parseSchemaExtension(parser)
+		if err != nil {
+			return nil, err
+		}
+		if len(definition.Directives) == 0 && len(definition.OperationTypes) == 0 {
+			return nil, unexpected(parser, lexer.Token{})
+		}
+		return ast.NewSchemaExtensionDefinition(&ast.SchemaExtensionDefinition{
+			Loc:        loc(parser, start),
+			Definition: definition,
+		}), nil
+	}
+	return nil, unexpected(parser, lexer.Token{})
+}
+
+/**
+ * SchemaExtension :
+ *   - extend schema Directives? { OperationTypeDefinition+ }
+ *   - extend schema Directives
+ */
+func parseSchemaExtension(parser *Parser) (*ast.SchemaDefinition, error) {
+	start := parser.Token.Start
+	_, err := expectKeyWord(parser, lexer.SCHEMA)
 	if err != nil {
 		return nil, err
 	}
-	return ast.NewTypeExtensionDefinition(&ast.TypeExtensionDefinition{
-		Loc:        loc(parser, start),
-		Definition: definition.(*ast.ObjectDefinition),
+	directives, err := parseDirectives(parser)
+	if err != nil {
+		return nil, err
+	}
+	operationTypes := []*ast.OperationTypeDefinition{}
+	if peek(parser, lexer.BRACE_L) {
+		operationTypesI, err := reverse(
+			parser,
+			lexer.BRACE_L, parseOperationTypeDefinition, lexer.BRACE_R,
+			false,
+		)
+		if err != nil {
+			return nil, err
+		}
+		for _, op := range operationTypesI {
+			if op, ok := op.(*ast.OperationTypeDefinition); ok {
+				operationTypes = append(operationTypes, op)
+			}
+		}
+	}
+	return ast.NewSchemaDefinition(&ast.SchemaDefinition{
+		OperationTypes: operationTypes,
+		Directives:     directives,
+		Loc:            loc(parser, start),
 	}), nil
 }
 
 /**
  * DirectiveDefinition :
- *   - directive @ Name ArgumentsDefinition? on DirectiveLocations
+ *   - directive @ Name ArgumentsDefinition? repeatable? on DirectiveLocations
  */
 func parseDirectiveDefinition(parser *Parser) (ast.Node, error) {
 	var (
@@ -1396,6 +1551,7 @@ func parseDirectiveDefinition(parser *Parser) (ast.Node, error) {
 		description *ast.StringValue
 		name        *ast.Name
 		args        []*ast.InputValueDefinition
+		repeatable  bool
 		locations   []*ast.Name
 	)
 	start := parser.Token.Start
@@ -1414,6 +1570,9 @@ func parseDirectiveDefinition(parser *Parser) (ast.Node, error) {
 	if args, err = parseArgumentDefs(parser); err != nil {
 		return nil, err
 	}
+	if repeatable, err = skipKeyWord(parser, "repeatable"); err != nil {
+		return nil, err
+	}
 	if _, err = expectKeyWord(parser, "on"); err != nil {
 		return nil, err
 	}
@@ -1426,6 +1585,7 @@ func parseDirectiveDefinition(parser *Parser) (ast.Node, error) {
 		Name:        name,
 		Description: description,
 		Arguments:   args,
+		Repeatable:  repeatable,
 		Locations:   locations,
 	}), nil
 }
@@ -1530,6 +1690,15 @@ func skip(parser *Parser, Kind lexer.TokenKind) (bool, error) {
 	return false, nil
 }
 
+// If the next token is a keyword with the given value, return true after advancing
+// the parser. Otherwise, do not change the parser state and return false.
+func skipKeyWord(parser *Parser, value string) (bool, error) {
+	if parser.Token.Kind == lexer.NAME && parser.Token.Value == value {
+		return true, advance(parser)
+	}
+	return false, nil
+}
+
 // If the next token is of the given kind, return that token after advancing
 // the parser. Otherwise, do not change the parser state and return error.
 func expect(parser *Parser, kind lexer.TokenKind) (lexer.Token, error) {
@@ -2,6 +2,7 @@ package parser
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/fraym/graphql-go/gqlerrors"
@@ -119,6 +120,145 @@ extend type Hello {
 	}
 }
 
+func TestSchemaParser_UnionExtension(t *testing.T) {
+	body := `extend union Hello = World`
+	astDoc := parse(t, body)
+	expected := ast.NewDocument(&ast.Document{
+		Loc: testLoc(0, 26),
+		Definitions: []ast.Node{
+			ast.NewUnionExtensionDefinition(&ast.UnionExtensionDefinition{
+				Loc: testLoc(0, 26),
+				Definition: ast.NewUnionDefinition(&ast.UnionDefinition{
+					Loc: testLoc(7, 26),
+					Name: ast.NewName(&ast.Name{
+						Value: "Hello",
+						Loc:   testLoc(13, 18),
+					}),
+					Directives: []*ast.Directive{},
+					Types: []*ast.Named{
+						ast.NewNamed(&ast.Named{
+							Loc: testLoc(21, 26),
+							Name: ast.NewName(&ast.Name{
+								Value: "World",
+								Loc:   testLoc(21, 26),
+							}),
+						}),
+					},
+				}),
+			}),
+		},
+	})
+	if !reflect.DeepEqual(astDoc, expected) {
+		t.Fatalf("unexpected document, expected: %v, got: %v", expected, astDoc)
+	}
+}
+
+func TestSchemaParser_SchemaExtensionWithoutOperationTypes(t *testing.T) {
+	body := `extend schema @foo`
+	astDoc := parse(t, body)
+	expected := ast.NewDocument(&ast.Document{
+		Loc: testLoc(0, 18),
+		Definitions: []ast.Node{
+			ast.NewSchemaExtensionDefinition(&ast.SchemaExtensionDefinition{
+				Loc: testLoc(0, 18),
+				Definition: ast.NewSchemaDefinition(&ast.SchemaDefinition{
+					Loc: testLoc(7, 18),
+					Directives: []*ast.Directive{
+						ast.NewDirective(&ast.Directive{
+							Loc: testLoc(14, 18),
+							Name: ast.NewName(&ast.Name{
+								Value: "foo",
+								Loc:   testLoc(15, 18),
+							}),
+							Arguments: []*ast.Argument{},
+						}),
+					},
+					OperationTypes: []*ast.OperationTypeDefinition{},
+				}),
+			}),
+		},
+	})
+	if !reflect.DeepEqual(astDoc, expected) {
+		t.Fatalf("unexpected document, expected: %v, got: %v", expected, astDoc)
+	}
+}
+
+func TestSchemaParser_TypeExtensionsOfAllKinds(t *testing.T) {
+	body := `
+extend type A implements I
+extend interface I @foo
+extend union U = A
+extend enum E { B }
+extend input In { c: Int }
+extend scalar S @foo
+extend schema { mutation: M }`
+	astDoc := parse(t, body)
+	kinds := []string{}
+	for _, def := range astDoc.Definitions {
+		kinds = append(kinds, def.GetKind())
+	}
+	expected := []string{
+		"TypeExtensionDefinition",
+		"InterfaceExtensionDefinition",
+		"UnionExtensionDefinition",
+		"EnumExtensionDefinition",
+		"InputObjectExtensionDefinition",
+		"ScalarExtensionDefinition",
+		"SchemaExtensionDefinition",
+	}
+	if !reflect.DeepEqual(kinds, expected) {
+		t.Fatalf("unexpected definitions, expected: %v, got: %v", expected, kinds)
+	}
+}
+
+func TestSchemaParser_EmptyExtensionsShouldFail(t *testing.T) {
+	for body, expected := range map[string]string{
+		`extend type Foo`:                    `Syntax Error GraphQL (1:16) Unexpected EOF`,
+		`extend interface Foo`:               `Syntax Error GraphQL (1:21) Unexpected EOF`,
+		`extend union U`:                     `Syntax Error GraphQL (1:15) Unexpected EOF`,
+		`extend enum E`:                      `Syntax Error GraphQL (1:14) Unexpected EOF`,
+		`extend input I`:                     `Syntax Error GraphQL (1:15) Unexpected EOF`,
+		`extend scalar S`:                    `Syntax Error GraphQL (1:16) Unexpected EOF`,
+		`extend schema`:                      `Syntax Error GraphQL (1:14) Unexpected EOF`,
+		"extend type Foo\ntype Bar { a: A }": `Syntax Error GraphQL (2:1) Unexpected Name "type"`,
+	} {
+		_, err := Parse(ParseParams{Source: body})
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Fatalf("unexpected error for %q: %v", body, err)
+		}
+	}
+}
+
+func TestSchemaParser_TypeWithoutFields(t *testing.T) {
+	body := `type Hello`
+	astDoc := parse(t, body)
+	expected := ast.NewDocument(&ast.Document{
+		Loc: testLoc(0, 10),
+		Definitions: []ast.Node{
+			ast.NewObjectDefinition(&ast.ObjectDefinition{
+				Loc: testLoc(0, 10),
+				Name: ast.NewName(&ast.Name{
+					Value: "Hello",
+					Loc:   testLoc(5, 10),
+				}),
+				Directives: []*ast.Directive{},
+				Interfaces: []*ast.Named{},
+				Fields:     []*ast.FieldDefinition{},
+			}),
+		},
+	})
+	if !reflect.DeepEqual(astDoc, expected) {
+		t.Fatalf("unexpected document, expected: %v, got: %v", expected, astDoc)
+	}
+}
+
+func TestSchemaParser_ExtensionOfOperationShouldFail(t *testing.T) {
+	_, err := Parse(ParseParams{Source: `extend query { a }`})
+	if err == nil || !strings.Contains(err.Error(), `Syntax Error GraphQL (1:8) Unexpected Name "query"`) {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
 func TestSchemaParser_SimpleNonNullType(t *testing.T) {
 	body := `
 type Hello {
@@ -358,6 +498,7 @@ interface Hello {
 					Value: "Hello",
 					Loc:   testLoc(11, 16),
 				}),
+				Interfaces: []*ast.Named{},
 				Directives: []*ast.Directive{},
 				Fields: []*ast.FieldDefinition{
 					ast.NewFieldDefinition(&ast.FieldDefinition{
@@ -385,6 +526,44 @@ interface Hello {
 	}
 }
 
+func TestSchemaParser_SimpleInterfaceInheritingMultipleInterfaces(t *testing.T) {
+	body := `interface Hello implements Wo & rld { }`
+	astDoc := parse(t, body)
+	expected := ast.NewDocument(&ast.Document{
+		Loc: testLoc(0, 39),
+		Definitions: []ast.Node{
+			ast.NewInterfaceDefinition(&ast.InterfaceDefinition{
+				Loc: testLoc(0, 39),
+				Name: ast.NewName(&ast.Name{
+					Value: "Hello",
+					Loc:   testLoc(10, 15),
+				}),
+				Interfaces: []*ast.Named{
+					ast.NewNamed(&ast.Named{
+						Name: ast.NewName(&ast.Name{
+							Value: "Wo",
+							Loc:   testLoc(27, 29),
+						}),
+						Loc: testLoc(27, 29),
+					}),
+					ast.NewNamed(&ast.Named{
+						Name: ast.NewName(&ast.Name{
+							Value: "rld",
+							Loc:   testLoc(32, 35),
+						}),
+						Loc: testLoc(32, 35),
+					}),
+				},
+				Directives: []*ast.Directive{},
+				Fields:     []*ast.FieldDefinition{},
+			}),
+		},
+	})
+	if !reflect.DeepEqual(astDoc, expected) {
+		t.Fatalf("unexpected document, expected: %v, got: %v", expected, astDoc)
+	}
+}
+
 func TestSchemaParser_SimpleFieldWithArg(t *testing.T) {
 	body := `
 type Hello {
@@ -826,3 +1005,35 @@ input Hello {
 		t.Fatalf("unexpected document, expected: %v, got: %v", expectedError, err)
 	}
 }
+
+func TestSchemaParser_RepeatableDirective(t *testing.T) {
+	body := `directive @tag repeatable on OBJECT | INTERFACE`
+	astDoc := parse(t, body)
+	expected := ast.NewDocument(&ast.Document{
+		Loc: testLoc(0, 47),
+		Definitions: []ast.Node{
+			ast.NewDirectiveDefinition(&ast.DirectiveDefinition{
+				Loc: testLoc(0, 47),
+				Name: ast.NewName(&ast.Name{
+					Value: "tag",
+					Loc:   testLoc(11, 14),
+				}),
+				Arguments:  []*ast.InputValueDefinition{},
+				Repeatable: true,
+				Locations: []*ast.Name{
+					ast.NewName(&ast.Name{
+						Value: "OBJECT",
+						Loc:   testLoc(29, 35),
+					}),
+					ast.NewName(&ast.Name{
+						Value: "INTERFACE",
+						Loc:   testLoc(38, 47),
+					}),
+				},
+			}),
+		},
+	})
+	if !reflect.DeepEqual(astDoc, expected) {
+		t.Fatalf("unexpected document, expected: %v, got: %v", expected, astDoc)
+	}
+}
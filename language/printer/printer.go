@@ -703,6 +703,7 @@ var printDocASTReducer = map[string]visitor.VisitFunc{
 		switch node := p.Node.(type) {
 		case *ast.InterfaceDefinition:
 			name := fmt.Sprintf("%v", node.Name)
+			interfaces := toSliceString(node.Interfaces)
 			fields := node.Fields
 			directives := []string{}
 			for _, directive := range node.Directives {
@@ -711,6 +712,7 @@ var printDocASTReducer = map[string]visitor.VisitFunc{
 			str := join([]string{
 				"interface",
 				name,
+				wrap("implements ", join(interfaces, " & "), ""),
 				join(directives, " "),
 				block(fields),
 			}, " ")
@@ -720,6 +722,7 @@ var printDocASTReducer = map[string]visitor.VisitFunc{
 			return visitor.ActionUpdate, str
 		case map[string]any:
 			name := getMapValueString(node, "Name")
+			interfaces := toSliceString(getMapValue(node, "Interfaces"))
 			fields := getMapValue(node, "Fields")
 			directives := []string{}
 			for _, directive := range getMapSliceValue(node, "Directives") {
@@ -728,6 +731,7 @@ var printDocASTReducer = map[string]visitor.VisitFunc{
 			str := join([]string{
 				"interface",
 				name,
+				wrap("implements ", join(interfaces, " & "), ""),
 				join(directives, " "),
 				block(fields),
 			}, " ")
@@ -901,6 +905,12 @@ var printDocASTReducer = map[string]visitor.VisitFunc{
 		}
 		return visitor.ActionNoChange, nil
 	},
+	"ScalarExtensionDefinition":      printExtensionDefinition,
+	"InterfaceExtensionDefinition":   printExtensionDefinition,
+	"UnionExtensionDefinition":       printExtensionDefinition,
+	"EnumExtensionDefinition":        printExtensionDefinition,
+	"InputObjectExtensionDefinition": printExtensionDefinition,
+	"SchemaExtensionDefinition":      printExtensionDefinition,
 	"DirectiveDefinition": func(p visitor.VisitFuncParams) (string, any) {
 		switch node := p.Node.(type) {
 		case *ast.DirectiveDefinition:
@@ -918,7 +928,11 @@ var printDocASTReducer = map[string]visitor.VisitFunc{
 			} else {
 				argsStr = wrap("(", join(args, ", "), ")")
 			}
-			str := fmt.Sprintf("directive @%v%v on %v", node.Name, argsStr, join(toSliceString(node.Locations), " | "))
+			repeatable := ""
+			if node.Repeatable {
+				repeatable = " repeatable"
+			}
+			str := fmt.Sprintf("directive @%v%v%v on %v", node.Name, argsStr, repeatable, join(toSliceString(node.Locations), " | "))
 			if desc := getDescription(node); desc != "" {
 				str = fmt.Sprintf("%s\n%s", desc, str)
 			}
@@ -940,7 +954,11 @@ var printDocASTReducer = map[string]visitor.VisitFunc{
 			} else {
 				argsStr = wrap("(", join(args, ", "), ")")
 			}
-			str := fmt.Sprintf("directive @%v%v on %v", name, argsStr, join(locations, " | "))
+			repeatable := ""
+			if isRepeatable, _ := getMapValue(node, "Repeatable").(bool); isRepeatable {
+				repeatable = " repeatable"
+			}
+			str := fmt.Sprintf("directive @%v%v%v on %v", name, argsStr, repeatable, join(locations, " | "))
 			if desc := getDescription(node); desc != "" {
 				str = fmt.Sprintf("%s\n%s", desc, str)
 			}
@@ -950,6 +968,30 @@ var printDocASTReducer = map[string]visitor.VisitFunc{
 	},
 }
 
+// printExtensionDefinition prints the extended definition prefixed with the extend keyword.
+func printExtensionDefinition(p visitor.VisitFuncParams) (string, any) {
+	var definition any
+	switch node := p.Node.(type) {
+	case *ast.ScalarExtensionDefinition:
+		definition = node.Definition
+	case *ast.InterfaceExtensionDefinition:
+		definition = node.Definition
+	case *ast.UnionExtensionDefinition:
+		definition = node.Definition
+	case *ast.EnumExtensionDefinition:
+		definition = node.Definition
+	case *ast.InputObjectExtensionDefinition:
+		definition = node.Definition
+	case *ast.SchemaExtensionDefinition:
+		definition = node.Definition
+	case map[string]any:
+		definition = getMapValueString(node, "Definition")
+	default:
+		return visitor.ActionNoChange, nil
+	}
+	return visitor.ActionUpdate, fmt.Sprintf("extend %v", definition)
+}
+
 func Print(astNode ast.Node) (printed any) {
 	defer func() any {
 		if r := recover(); r != nil {
@@ -80,6 +80,11 @@ interface AnnotatedInterface @onInterface {
   annotatedField(arg: Type @onArg): Type @onField
 }
 
+interface Resource implements Bar & Node {
+  one: Type
+  four(argument: String = "string"): String
+}
+
 union Feed = Story | Article | Advert
 
 union AnnotatedUnion @onUnion = A | B
@@ -115,9 +120,35 @@ extend type Foo @onType {}
 
 type NoFields {}
 
+extend interface Bar {
+  two(argument: InputType!): Type
+}
+
+extend interface Resource implements Baz {
+  two(argument: InputType!): Type
+}
+
+extend union Feed = Photo | Video
+
+extend enum Site {
+  VR
+}
+
+extend input InputType {
+  other: Float = 1.23e4
+}
+
+extend scalar CustomScalar @onScalar
+
+extend schema @onSchema {
+  subscription: SubscriptionType
+}
+
 directive @skip(if: Boolean!) on FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT
 
 directive @include(if: Boolean!) on FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT
+
+directive @myRepeatableDir(name: String!) repeatable on OBJECT | INTERFACE
 `
 	results := printer.Print(astDoc)
 	if !reflect.DeepEqual(expected, results) {
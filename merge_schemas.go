@@ -0,0 +1,693 @@
+package graphql
+
+import (
+	"sort"
+
+	"github.com/fraym/graphql-go/gqlerrors"
+	"github.com/fraym/graphql-go/language/ast"
+)
+
+// MergeConflictStrategy decides how MergeSchemas handles a type or root field
+// defined by more than one schema.
+type MergeConflictStrategy int
+
+const (
+	// MergeConflictError fails the merge on the first conflict.
+	MergeConflictError MergeConflictStrategy = iota
+
+	// MergeConflictPrefix renames conflicting types and root fields of every schema
+	// but the first defining them with the prefix configured for the schema.
+	MergeConflictPrefix
+
+	// MergeConflictPreferLast keeps the type or root field of the schema merged last.
+	MergeConflictPreferLast
+)
+
+// MergeSchemasConfig configures MergeSchemas.
+type MergeSchemasConfig struct {
+	// Schemas are merged in order.
+	Schemas []Schema
+
+	// OnConflict decides how types and root fields defined by more than one schema
+	// are merged. Types that are printed identically by the schemas are shared and
+	// never conflict.
+	OnConflict MergeConflictStrategy
+
+	// Prefixes holds the prefix of each schema, by index, used by MergeConflictPrefix.
+	// The prefix is prepended as is to the names of conflicting types and root fields.
+	Prefixes []string
+
+	// Extensions are the extensions of the merged schema.
+	Extensions []Extension
+}
+
+// MergeSchemas returns a new Schema combining the root Query, Mutation and
+// Subscription fields and the types of the given schemas. The given schemas
+// are left unchanged.
+//
+// Root fields of the merged schema delegate to the schema defining them: the
+// selection of the field is executed against that schema, so its resolvers and
+// Extensions run as usual. Nested fields read their value from the delegated
+// result and abstract types are resolved by the __typename of the result. The
+// data and all errors of the delegated execution are kept, the errors at the
+// same paths they have in the merged schema.
+//
+// Custom directives of the first schema defining them are kept.
+func MergeSchemas(config MergeSchemasConfig) (Schema, error) {
+	m := &schemaMerger{
+		config:     config,
+		types:      map[string]Type{},
+		rootFields: map[string]Fields{},
+	}
+	for i, schema := range config.Schemas {
+		if schema.QueryType() == nil {
+			return Schema{}, gqlerrors.NewFormattedError("Must provide valid Schema")
+		}
+		s := &mergedSubSchema{
+			merger:            m,
+			index:             i,
+			schema:            schema,
+			typeNames:         map[string]string{},
+			originalTypeNames: map[string]string{},
+		}
+		if i < len(config.Prefixes) {
+			s.prefix = config.Prefixes[i]
+		}
+		m.subSchemas = append(m.subSchemas, s)
+	}
+
+	if err := m.mergeTypeNames(); err != nil {
+		return Schema{}, err
+	}
+	for _, operation := range []string{ast.OperationTypeQuery, ast.OperationTypeMutation, ast.OperationTypeSubscription} {
+		if err := m.mergeRootFields(operation); err != nil {
+			return Schema{}, err
+		}
+	}
+
+	schemaConfig := SchemaConfig{
+		Directives: m.mergeDirectives(),
+		Extensions: config.Extensions,
+	}
+	if len(m.rootFields[ast.OperationTypeQuery]) > 0 {
+		schemaConfig.Query = m.types[rootTypeNames[ast.OperationTypeQuery]].(*Object)
+	}
+	if len(m.rootFields[ast.OperationTypeMutation]) > 0 {
+		schemaConfig.Mutation = m.types[rootTypeNames[ast.OperationTypeMutation]].(*Object)
+	}
+	if len(m.rootFields[ast.OperationTypeSubscription]) > 0 {
+		schemaConfig.Subscription = m.types[rootTypeNames[ast.OperationTypeSubscription]].(*Object)
+	}
+	typeNames := make([]string, 0, len(m.types))
+	for name := range m.types {
+		if _, ok := rootOperations[name]; !ok {
+			typeNames = append(typeNames, name)
+		}
+	}
+	sort.Strings(typeNames)
+	for _, name := range typeNames {
+		schemaConfig.Types = append(schemaConfig.Types, m.types[name])
+	}
+	return NewSchema(schemaConfig)
+}
+
+// rootTypeNames are the names of the root types of a merged schema by operation.
+var rootTypeNames = map[string]string{
+	ast.OperationTypeQuery:        "Query",
+	ast.OperationTypeMutation:     "Mutation",
+	ast.OperationTypeSubscription: "Subscription",
+}
+
+// rootOperations are the operations of the root types of a merged schema by name.
+var rootOperations = map[string]string{
+	"Query":        ast.OperationTypeQuery,
+	"Mutation":     ast.OperationTypeMutation,
+	"Subscription": ast.OperationTypeSubscription,
+}
+
+type schemaMerger struct {
+	config     MergeSchemasConfig
+	subSchemas []*mergedSubSchema
+
+	// types holds the types of the merged schema by name.
+	types map[string]Type
+
+	// rootFields holds the fields of the merged root types by operation.
+	rootFields map[string]Fields
+}
+
+type mergedSubSchema struct {
+	merger *schemaMerger
+	index  int
+	schema Schema
+	prefix string
+
+	// typeNames maps the type names of the schema to those of the merged schema.
+	typeNames map[string]string
+
+	// originalTypeNames maps the type names of the merged schema to those of the schema.
+	originalTypeNames map[string]string
+}
+
+func (s *mergedSubSchema) rootTypes() map[string]*Object {
+	return map[string]*Object{
+		ast.OperationTypeQuery:        s.schema.QueryType(),
+		ast.OperationTypeMutation:     s.schema.MutationType(),
+		ast.OperationTypeSubscription: s.schema.SubscriptionType(),
+	}
+}
+
+// mergeTypeNames decides which schema owns each type of the merged schema and
+// under which name, then creates the merged types.
+func (m *schemaMerger) mergeTypeNames() error {
+	owners := map[string]*mergedSubSchema{}
+	ownedTypes := map[string]Type{}
+	for _, s := range m.subSchemas {
+		roots := map[string]string{}
+		for operation, root := range s.rootTypes() {
+			if root != nil {
+				roots[root.Name()] = rootTypeNames[operation]
+				s.originalTypeNames[rootTypeNames[operation]] = root.Name()
+			}
+		}
+
+		typeMap := s.schema.TypeMap()
+		for _, name := range sortedTypeNames(typeMap) {
+			ttype := typeMap[name]
+			if isIntrospectionType(ttype) || isSpecifiedScalarType(ttype) {
+				continue
+			}
+			if rootName, ok := roots[name]; ok {
+				s.typeNames[name] = rootName
+				continue
+			}
+
+			mergedName := name
+			owner, defined := owners[name]
+			_, reserved := rootOperations[name]
+			if defined && printTypeDefinition(owner.schema, ownedTypes[name]) == printTypeDefinition(s.schema, ttype) {
+				s.typeNames[name] = name
+				continue
+			}
+			if defined || reserved {
+				switch {
+				case m.config.OnConflict == MergeConflictPrefix:
+					if err := invariantf(
+						s.prefix != "",
+						`Type "%v" is defined by more than one schema, a prefix is required for schema %v.`,
+						name, s.index,
+					); err != nil {
+						return err
+					}
+					mergedName = s.prefix + name
+					if _, taken := owners[mergedName]; taken {
+						return invariantf(false, `Type "%v" is defined by more than one schema.`, mergedName)
+					}
+				case m.config.OnConflict != MergeConflictPreferLast || reserved:
+					return invariantf(false, `Type "%v" is defined by more than one schema.`, name)
+				}
+			}
+			owners[mergedName] = s
+			ownedTypes[mergedName] = ttype
+			s.typeNames[name] = mergedName
+			s.originalTypeNames[mergedName] = name
+		}
+	}
+
+	for operation, name := range rootTypeNames {
+		fields := func() Fields {
+			return m.rootFields[operation]
+		}
+		m.types[name] = NewObject(ObjectConfig{
+			Name:   name,
+			Fields: FieldsThunk(fields),
+		})
+	}
+	for name, ttype := range ownedTypes {
+		m.types[name] = owners[name].mergeType(name, ttype)
+	}
+	return nil
+}
+
+// mergeRootFields collects the root fields of the operation from every schema.
+func (m *schemaMerger) mergeRootFields(operation string) error {
+	fields := Fields{}
+	for _, s := range m.subSchemas {
+		root := s.rootTypes()[operation]
+		if root == nil {
+			continue
+		}
+		fieldMap := root.Fields()
+		fieldNames := make([]string, 0, len(fieldMap))
+		for fieldName := range fieldMap {
+			fieldNames = append(fieldNames, fieldName)
+		}
+		sort.Strings(fieldNames)
+		for _, fieldName := range fieldNames {
+			mergedName := fieldName
+			if _, defined := fields[fieldName]; defined {
+				switch m.config.OnConflict {
+				case MergeConflictPrefix:
+					if err := invariantf(
+						s.prefix != "",
+						`Field "%v.%v" is defined by more than one schema, a prefix is required for schema %v.`,
+						rootTypeNames[operation], fieldName, s.index,
+					); err != nil {
+						return err
+					}
+					mergedName = s.prefix + fieldName
+					if _, taken := fields[mergedName]; taken {
+						return invariantf(false, `Field "%v.%v" is defined by more than one schema.`, rootTypeNames[operation], mergedName)
+					}
+				case MergeConflictError:
+					return invariantf(false, `Field "%v.%v" is defined by more than one schema.`, rootTypeNames[operation], fieldName)
+				}
+			}
+			fields[mergedName] = s.mergeRootField(operation, mergedName, fieldMap[fieldName])
+		}
+	}
+	m.rootFields[operation] = fields
+	return nil
+}
+
+// mergeDirectives returns the specified directives and the custom directives of
+// the first schema defining them.
+func (m *schemaMerger) mergeDirectives() []*Directive {
+	directives := append([]*Directive{}, SpecifiedDirectives...)
+	defined := map[string]bool{}
+	for _, directive := range directives {
+		defined[directive.Name] = true
+	}
+	for _, s := range m.subSchemas {
+		for _, directive := range s.schema.Directives() {
+			if !defined[directive.Name] {
+				defined[directive.Name] = true
+				directives = append(directives, directive)
+			}
+		}
+	}
+	return directives
+}
+
+// mergedType returns the type of the merged schema that replaces the given type of the schema.
+func (s *mergedSubSchema) mergedType(ttype Type) Type {
+	switch ttype := ttype.(type) {
+	case *List:
+		return NewList(s.mergedType(ttype.OfType))
+	case *NonNull:
+		return NewNonNull(s.mergedType(ttype.OfType))
+	}
+	if merged, ok := s.merger.types[s.typeNames[ttype.Name()]]; ok {
+		return merged
+	}
+	return ttype
+}
+
+// mergedValue converts a default value of the schema to the value of the merged type.
+func (s *mergedSubSchema) mergedValue(value any, ttype Type, mergedType Type) any {
+	input, ok := mergedType.(Input)
+	if value == nil || !ok {
+		return value
+	}
+	valueAST := astFromValue(value, ttype)
+	if valueAST == nil {
+		return value
+	}
+	if merged, err := valueFromAST(valueAST, input, nil); err == nil {
+		return merged
+	}
+	return value
+}
+
+// mergeType rebuilds a type of the schema for the merged schema. Scalars and enums
+// keep their serialized values, since values are resolved by the owning schema.
+func (s *mergedSubSchema) mergeType(name string, ttype Type) Type {
+	switch ttype := ttype.(type) {
+	case *Scalar:
+		return NewScalar(ScalarConfig{
+			Name:              name,
+			Description:       ttype.Description(),
+			Serialize:         identityValue,
+			ParseValue:        ttype.scalarConfig.ParseValue,
+			ParseLiteral:      ttype.scalarConfig.ParseLiteral,
+			SpecifiedByURL:    ttype.SpecifiedByURL(),
+			AppliedDirectives: ttype.AppliedDirectives(),
+		})
+	case *Object:
+		return NewObject(ObjectConfig{
+			Name:              name,
+			Description:       ttype.PrivateDescription,
+			AppliedDirectives: ttype.AppliedDirectives(),
+			Interfaces: InterfacesThunk(func() []*Interface {
+				return s.mergeInterfaces(ttype.Interfaces())
+			}),
+			Fields: FieldsThunk(func() Fields {
+				return s.mergeFields(ttype.Fields())
+			}),
+		})
+	case *Interface:
+		return NewInterface(InterfaceConfig{
+			Name:              name,
+			Description:       ttype.Description(),
+			ResolveType:       s.resolveTypeByTypename,
+			AppliedDirectives: ttype.AppliedDirectives(),
+			Interfaces: InterfacesThunk(func() []*Interface {
+				return s.mergeInterfaces(ttype.Interfaces())
+			}),
+			Fields: FieldsThunk(func() Fields {
+				return s.mergeFields(ttype.Fields())
+			}),
+		})
+	case *Union:
+		return NewUnion(UnionConfig{
+			Name:              name,
+			Description:       ttype.Description(),
+			ResolveType:       s.resolveTypeByTypename,
+			AppliedDirectives: ttype.AppliedDirectives(),
+			Types: UnionTypesThunk(func() []*Object {
+				types := []*Object{}
+				for _, member := range ttype.Types() {
+					types = append(types, s.mergedType(member).(*Object))
+				}
+				return types
+			}),
+		})
+	case *Enum:
+		values := EnumValueConfigMap{}
+		for _, value := range ttype.Values() {
+			values[value.Name] = &EnumValueConfig{
+				Value:             value.Name,
+				Description:       value.Description,
+				DeprecationReason: value.DeprecationReason,
+				AppliedDirectives: value.AppliedDirectives,
+			}
+		}
+		return NewEnum(EnumConfig{
+			Name:              name,
+			Description:       ttype.Description(),
+			Values:            values,
+			AppliedDirectives: ttype.AppliedDirectives(),
+		})
+	case *InputObject:
+		return NewInputObject(InputObjectConfig{
+			Name:              name,
+			Description:       ttype.Description(),
+			IsOneOf:           ttype.IsOneOf(),
+			AppliedDirectives: ttype.AppliedDirectives(),
+			Fields: InputObjectConfigFieldMapThunk(func() InputObjectConfigFieldMap {
+				fields := InputObjectConfigFieldMap{}
+				for fieldName, field := range ttype.Fields() {
+					fieldType := s.mergedType(field.Type)
+					fields[fieldName] = &InputObjectFieldConfig{
+						Type:              fieldType,
+						Description:       field.Description(),
+						DefaultValue:      s.mergedValue(field.DefaultValue, field.Type, fieldType),
+						DeprecationReason: field.DeprecationReason,
+						AppliedDirectives: field.AppliedDirectives,
+					}
+				}
+				return fields
+			}),
+		})
+	}
+	return ttype
+}
+
+func (s *mergedSubSchema) mergeInterfaces(interfaces []*Interface) []*Interface {
+	ifaces := []*Interface{}
+	for _, iface := range interfaces {
+		ifaces = append(ifaces, s.mergedType(iface).(*Interface))
+	}
+	return ifaces
+}
+
+func (s *mergedSubSchema) mergeFields(fieldMap FieldDefinitionMap) Fields {
+	fields := Fields{}
+	for fieldName, field := range fieldMap {
+		fields[fieldName] = &Field{
+			Name:              field.Name,
+			Description:       field.Description,
+			Type:              s.mergedType(field.Type),
+			Args:              s.mergeArgs(field.Args),
+			Resolve:           resolveDelegatedValue,
+			DeprecationReason: field.DeprecationReason,
+			AppliedDirectives: field.AppliedDirectives,
+			IsVisible:         field.IsVisible,
+			Timeout:           field.Timeout,
+		}
+	}
+	return fields
+}
+
+func (s *mergedSubSchema) mergeArgs(args []*Argument) FieldConfigArgument {
+	configs := FieldConfigArgument{}
+	for _, arg := range args {
+		argType := s.mergedType(arg.Type)
+		configs = append(configs, &ArgumentConfig{
+			Name:              arg.Name(),
+			Description:       arg.Description(),
+			Type:              argType,
+			DefaultValue:      s.mergedValue(arg.DefaultValue, arg.Type, argType),
+			DeprecationReason: arg.DeprecationReason,
+			AppliedDirectives: arg.AppliedDirectives,
+		})
+	}
+	return configs
+}
+
+func (s *mergedSubSchema) mergeRootField(operation string, name string, field *FieldDefinition) *Field {
+	return &Field{
+		Name:              name,
+		Description:       field.Description,
+		Type:              s.mergedType(field.Type).(Output),
+		Args:              s.mergeArgs(field.Args),
+		Resolve:           s.delegate(operation, field.Name),
+		Subscribe:         field.Subscribe,
+		DeprecationReason: field.DeprecationReason,
+		AppliedDirectives: field.AppliedDirectives,
+		IsVisible:         field.IsVisible,
+		Timeout:           field.Timeout,
+	}
+}
+
+// resolveTypeByTypename resolves abstract types of the merged schema by the
+// __typename the schema added to the delegated result.
+func (s *mergedSubSchema) resolveTypeByTypename(p ResolveTypeParams) *Object {
+	if value, ok := p.Value.(map[string]any); ok {
+		if typename, ok := value[TypeNameMetaFieldDef.Name].(string); ok {
+			if object, ok := p.Info.Schema.Type(s.typeNames[typename]).(*Object); ok {
+				return object
+			}
+		}
+	}
+	return nil
+}
+
+// resolveDelegatedValue resolves the fields of merged types from the result of
+// the delegated execution, which holds the values by response key.
+func resolveDelegatedValue(p ResolveParams) (any, error) {
+	source, ok := p.Source.(map[string]any)
+	if !ok || p.Info.Path == nil {
+		return nil, nil
+	}
+	responseKey, _ := p.Info.Path.Key.(string)
+	return source[responseKey], nil
+}
+
+// delegate returns the resolver of a merged root field, which executes the
+// selection of the field against the schema defining it.
+func (s *mergedSubSchema) delegate(operation string, fieldName string) FieldResolveFn {
+	return func(p ResolveParams) (any, error) {
+		responseKey := fieldName
+		if p.Info.Path != nil {
+			if key, ok := p.Info.Path.Key.(string); ok {
+				responseKey = key
+			}
+		}
+		d := &delegation{
+			subSchema: s,
+			info:      p.Info,
+			fragments: map[string]bool{},
+			variables: map[string]bool{},
+		}
+		result := Execute(ExecuteParams{
+			Schema:  s.schema,
+			Root:    p.Source,
+			AST:     d.document(operation, fieldName, responseKey),
+			Args:    p.Info.VariableValues,
+			Context: p.Context,
+		})
+		var value any
+		if data, ok := result.Data.(map[string]any); ok {
+			value = data[responseKey]
+		}
+		if len(result.Errors) == 0 {
+			return value, nil
+		}
+		// the errors keep the paths they have below the merged field
+		errs := make(delegatedErrors, 0, len(result.Errors))
+		for _, err := range result.Errors {
+			if len(err.Path) > 0 && p.Info.Path != nil {
+				err.Path = append(p.Info.Path.AsArray(), err.Path[1:]...)
+			}
+			errs = append(errs, err)
+		}
+		return value, errs
+	}
+}
+
+// delegation builds the document executed against a schema for a merged root field.
+// It contains the selection of the field, the fragments and variable definitions it
+// uses, with type names mapped back to those of the schema and __typename selected
+// for every nested object.
+type delegation struct {
+	subSchema   *mergedSubSchema
+	info        ResolveInfo
+	definitions []ast.Node
+	fragments   map[string]bool
+	variables   map[string]bool
+}
+
+func (d *delegation) document(operation string, fieldName string, responseKey string) *ast.Document {
+	selections := []ast.Selection{}
+	for _, fieldAST := range d.info.FieldASTs {
+		d.collectArgumentVariables(fieldAST.Arguments)
+		selections = append(selections, ast.NewField(&ast.Field{
+			Loc:          fieldAST.Loc,
+			Alias:        ast.NewName(&ast.Name{Value: responseKey}),
+			Name:         ast.NewName(&ast.Name{Value: fieldName}),
+			Arguments:    fieldAST.Arguments,
+			SelectionSet: d.selectionSet(fieldAST.SelectionSet),
+		}))
+	}
+
+	variableDefinitions := []*ast.VariableDefinition{}
+	if op, ok := d.info.Operation.(*ast.OperationDefinition); ok {
+		for _, def := range op.VariableDefinitions {
+			if d.variables[def.Variable.Name.Value] {
+				variableDefinitions = append(variableDefinitions, ast.NewVariableDefinition(&ast.VariableDefinition{
+					Variable:     def.Variable,
+					Type:         d.typeAST(def.Type),
+					DefaultValue: def.DefaultValue,
+				}))
+			}
+		}
+	}
+
+	definitions := []ast.Node{ast.NewOperationDefinition(&ast.OperationDefinition{
+		Operation:           operation,
+		VariableDefinitions: variableDefinitions,
+		SelectionSet:        ast.NewSelectionSet(&ast.SelectionSet{Selections: selections}),
+	})}
+	return ast.NewDocument(&ast.Document{
+		Definitions: append(definitions, d.definitions...),
+	})
+}
+
+// selectionSet copies the selection set of a field and adds __typename to it.
+func (d *delegation) selectionSet(set *ast.SelectionSet) *ast.SelectionSet {
+	if set == nil {
+		return nil
+	}
+	copied := d.selections(set)
+	copied.Selections = append(copied.Selections, ast.NewField(&ast.Field{
+		Name: ast.NewName(&ast.Name{Value: TypeNameMetaFieldDef.Name}),
+	}))
+	return copied
+}
+
+func (d *delegation) selections(set *ast.SelectionSet) *ast.SelectionSet {
+	selections := []ast.Selection{}
+	for _, selection := range set.Selections {
+		switch selection := selection.(type) {
+		case *ast.Field:
+			d.collectArgumentVariables(selection.Arguments)
+			d.collectDirectiveVariables(selection.Directives)
+			selections = append(selections, ast.NewField(&ast.Field{
+				Loc:          selection.Loc,
+				Alias:        selection.Alias,
+				Name:         selection.Name,
+				Arguments:    selection.Arguments,
+				Directives:   selection.Directives,
+				SelectionSet: d.selectionSet(selection.SelectionSet),
+			}))
+		case *ast.InlineFragment:
+			d.collectDirectiveVariables(selection.Directives)
+			var typeCondition *ast.Named
+			if selection.TypeCondition != nil {
+				typeCondition = d.typeAST(selection.TypeCondition).(*ast.Named)
+			}
+			selections = append(selections, ast.NewInlineFragment(&ast.InlineFragment{
+				TypeCondition: typeCondition,
+				Directives:    selection.Directives,
+				SelectionSet:  d.selections(selection.SelectionSet),
+			}))
+		case *ast.FragmentSpread:
+			d.collectDirectiveVariables(selection.Directives)
+			d.fragment(selection.Name.Value)
+			selections = append(selections, selection)
+		}
+	}
+	return ast.NewSelectionSet(&ast.SelectionSet{Selections: selections})
+}
+
+// fragment adds a copy of the named fragment to the document, once.
+func (d *delegation) fragment(name string) {
+	if d.fragments[name] {
+		return
+	}
+	d.fragments[name] = true
+	fragment, ok := d.info.Fragments[name].(*ast.FragmentDefinition)
+	if !ok {
+		return
+	}
+	d.collectDirectiveVariables(fragment.Directives)
+	d.definitions = append(d.definitions, ast.NewFragmentDefinition(&ast.FragmentDefinition{
+		Name:          fragment.Name,
+		TypeCondition: d.typeAST(fragment.TypeCondition).(*ast.Named),
+		Directives:    fragment.Directives,
+		SelectionSet:  d.selections(fragment.SelectionSet),
+	}))
+}
+
+// typeAST maps the type names of a type reference back to those of the schema.
+func (d *delegation) typeAST(ttype ast.Type) ast.Type {
+	switch ttype := ttype.(type) {
+	case *ast.List:
+		return ast.NewList(&ast.List{Type: d.typeAST(ttype.Type)})
+	case *ast.NonNull:
+		return ast.NewNonNull(&ast.NonNull{Type: d.typeAST(ttype.Type)})
+	case *ast.Named:
+		if name, ok := d.subSchema.originalTypeNames[ttype.Name.Value]; ok {
+			return ast.NewNamed(&ast.Named{Name: ast.NewName(&ast.Name{Value: name})})
+		}
+	}
+	return ttype
+}
+
+func (d *delegation) collectDirectiveVariables(directives []*ast.Directive) {
+	for _, directive := range directives {
+		d.collectArgumentVariables(directive.Arguments)
+	}
+}
+
+func (d *delegation) collectArgumentVariables(args []*ast.Argument) {
+	for _, arg := range args {
+		d.collectVariables(arg.Value)
+	}
+}
+
+func (d *delegation) collectVariables(value ast.Value) {
+	switch value := value.(type) {
+	case *ast.Variable:
+		d.variables[value.Name.Value] = true
+	case *ast.ListValue:
+		for _, item := range value.Values {
+			d.collectVariables(item)
+		}
+	case *ast.ObjectValue:
+		for _, field := range value.Fields {
+			d.collectVariables(field.Value)
+		}
+	}
+}
@@ -0,0 +1,267 @@
+package graphql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildMergeTestSchema(t *testing.T, sdl string, resolvers map[string]graphql.FieldResolveFn, ext graphql.Extension) graphql.Schema {
+	schema, err := graphql.BuildSchema(sdl, graphql.BuildSchemaOptions{Resolvers: resolvers})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ext != nil {
+		schema.AddExtensions(ext)
+	}
+	return schema
+}
+
+func countingMergeTestExt(name string, count *int) *testExt {
+	ext := newtestExt(name)
+	ext.executionDidStartFn = func(ctx context.Context) (context.Context, graphql.ExecutionFinishFunc) {
+		*count++
+		return ctx, func(r *graphql.Result) {}
+	}
+	return ext
+}
+
+func TestMergeSchemas_DelegatesToSubSchemas(t *testing.T) {
+	usersExecutions, postsExecutions := 0, 0
+	users := buildMergeTestSchema(t, `
+		enum Role { ADMIN USER }
+		type User { id: ID! name: String role: Role }
+		type Query { user(id: ID!): User }
+	`, map[string]graphql.FieldResolveFn{
+		"Query.user": func(p graphql.ResolveParams) (any, error) {
+			return map[string]any{"id": p.Args["id"], "name": "Ada", "role": "ADMIN"}, nil
+		},
+	}, countingMergeTestExt("users", &usersExecutions))
+	posts := buildMergeTestSchema(t, `
+		interface Node { id: ID! }
+		type Post implements Node { id: ID! title: String }
+		type Query { posts(first: Int = 10): [Post] node(id: ID!): Node }
+		type Mutation { publish(title: String!): Post }
+	`, map[string]graphql.FieldResolveFn{
+		"Query.posts": func(p graphql.ResolveParams) (any, error) {
+			return []any{map[string]any{"id": "p1", "title": "Hello"}}, nil
+		},
+		"Query.node": func(p graphql.ResolveParams) (any, error) {
+			return map[string]any{"__typename": "Post", "id": p.Args["id"], "title": "Found"}, nil
+		},
+		"Mutation.publish": func(p graphql.ResolveParams) (any, error) {
+			return map[string]any{"id": "p2", "title": p.Args["title"]}, nil
+		},
+	}, countingMergeTestExt("posts", &postsExecutions))
+
+	schema, err := graphql.MergeSchemas(graphql.MergeSchemasConfig{
+		Schemas: []graphql.Schema{users, posts},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema: schema,
+		RequestString: `query ($id: ID!) {
+			author: user(id: $id) { name role }
+			posts { ...PostFields }
+			node(id: "p3") { id ... on Post { title } }
+		}
+		fragment PostFields on Post { id title }`,
+		VariableValues: map[string]any{"id": "u1"},
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{
+		"author": map[string]any{"name": "Ada", "role": "ADMIN"},
+		"posts":  []any{map[string]any{"id": "p1", "title": "Hello"}},
+		"node":   map[string]any{"id": "p3", "title": "Found"},
+	}, result.Data)
+	assert.Equal(t, 1, usersExecutions)
+	assert.Equal(t, 2, postsExecutions)
+
+	result = graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `mutation { publish(title: "New") { title } }`,
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{"publish": map[string]any{"title": "New"}}, result.Data)
+}
+
+func TestMergeSchemas_ReportsErrorsOfSubSchemas(t *testing.T) {
+	users := buildMergeTestSchema(t, `type Query { user: String }`, map[string]graphql.FieldResolveFn{
+		"Query.user": func(p graphql.ResolveParams) (any, error) {
+			return nil, assert.AnError
+		},
+	}, nil)
+	schema, err := graphql.MergeSchemas(graphql.MergeSchemasConfig{
+		Schemas: []graphql.Schema{users},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ user }`,
+	})
+	assert.Equal(t, map[string]any{"user": nil}, result.Data)
+	if assert.Len(t, result.Errors, 1) {
+		assert.Equal(t, assert.AnError.Error(), result.Errors[0].Message)
+		assert.Equal(t, []any{"user"}, result.Errors[0].Path)
+	}
+}
+
+func TestMergeSchemas_KeepsPartialDataOfSubSchemas(t *testing.T) {
+	users := buildMergeTestSchema(t, `
+		type User { name: String email: String phone: String! }
+		type Query { user: User users: [User] }
+	`, map[string]graphql.FieldResolveFn{
+		"Query.user": func(p graphql.ResolveParams) (any, error) {
+			return map[string]any{"name": "ada"}, nil
+		},
+		"Query.users": func(p graphql.ResolveParams) (any, error) {
+			return []any{map[string]any{"name": "ada", "phone": "123"}, map[string]any{"name": "bob"}}, nil
+		},
+		"User.email": func(p graphql.ResolveParams) (any, error) {
+			return nil, assert.AnError
+		},
+	}, nil)
+	schema, err := graphql.MergeSchemas(graphql.MergeSchemasConfig{
+		Schemas: []graphql.Schema{users},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	query := `{
+		person: user { name email }
+		users { name phone }
+	}`
+	expected := graphql.Do(graphql.Params{Schema: users, RequestString: query})
+	result := graphql.Do(graphql.Params{Schema: schema, RequestString: query})
+	assert.Equal(t, map[string]any{
+		"person": map[string]any{"name": "ada", "email": nil},
+		"users":  []any{map[string]any{"name": "ada", "phone": "123"}, nil},
+	}, result.Data)
+	assert.Equal(t, expected.Data, result.Data)
+	if !testutil.EqualFormattedErrors(expected.Errors, result.Errors) {
+		t.Fatalf("Unexpected errors, Diff: %v", testutil.Diff(expected.Errors, result.Errors))
+	}
+	if assert.Len(t, result.Errors, 2) {
+		assert.Equal(t, []any{"person", "email"}, result.Errors[0].Path)
+		assert.Equal(t, []any{"users", 1, "phone"}, result.Errors[1].Path)
+	}
+}
+
+func TestMergeSchemas_ResolvesConflicts(t *testing.T) {
+	first := buildMergeTestSchema(t, `
+		scalar Date
+		type Item { name: String }
+		type Query { item: Item date: Date }
+	`, map[string]graphql.FieldResolveFn{
+		"Query.item": func(p graphql.ResolveParams) (any, error) {
+			return map[string]any{"name": "first"}, nil
+		},
+	}, nil)
+	second := buildMergeTestSchema(t, `
+		scalar Date
+		type Item { id: ID! }
+		union Result = Item
+		type Query { item(id: ID!): Item search: [Result] }
+	`, map[string]graphql.FieldResolveFn{
+		"Query.item": func(p graphql.ResolveParams) (any, error) {
+			return map[string]any{"id": p.Args["id"]}, nil
+		},
+		"Query.search": func(p graphql.ResolveParams) (any, error) {
+			return []any{map[string]any{"__typename": "Item", "id": "s1"}}, nil
+		},
+	}, nil)
+
+	_, err := graphql.MergeSchemas(graphql.MergeSchemasConfig{
+		Schemas: []graphql.Schema{first, second},
+	})
+	assert.EqualError(t, err, `Type "Item" is defined by more than one schema.`)
+
+	_, err = graphql.MergeSchemas(graphql.MergeSchemasConfig{
+		Schemas:    []graphql.Schema{first, second},
+		OnConflict: graphql.MergeConflictPrefix,
+	})
+	assert.EqualError(t, err, `Type "Item" is defined by more than one schema, a prefix is required for schema 1.`)
+
+	prefixed, err := graphql.MergeSchemas(graphql.MergeSchemasConfig{
+		Schemas:    []graphql.Schema{first, second},
+		OnConflict: graphql.MergeConflictPrefix,
+		Prefixes:   []string{"", "second_"},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `scalar Date
+
+type Item {
+  name: String
+}
+
+type Query {
+  date: Date
+  item: Item
+  search: [Result]
+  second_item(id: ID!): second_Item
+}
+
+union Result = second_Item
+
+type second_Item {
+  id: ID!
+}`
+	if printed := graphql.PrintSchema(prefixed); printed != expected {
+		t.Fatalf("Unexpected SDL, Diff: %v", testutil.Diff(expected, printed))
+	}
+	result := graphql.Do(graphql.Params{
+		Schema: prefixed,
+		RequestString: `{
+			item { name }
+			second_item(id: "i1") { id }
+			search { __typename ... on second_Item { id } }
+		}`,
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{
+		"item":        map[string]any{"name": "first"},
+		"second_item": map[string]any{"id": "i1"},
+		"search":      []any{map[string]any{"__typename": "second_Item", "id": "s1"}},
+	}, result.Data)
+
+	preferLast, err := graphql.MergeSchemas(graphql.MergeSchemasConfig{
+		Schemas:    []graphql.Schema{first, second},
+		OnConflict: graphql.MergeConflictPreferLast,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = `scalar Date
+
+type Item {
+  id: ID!
+}
+
+type Query {
+  date: Date
+  item(id: ID!): Item
+  search: [Result]
+}
+
+union Result = Item`
+	if printed := graphql.PrintSchema(preferLast); printed != expected {
+		t.Fatalf("Unexpected SDL, Diff: %v", testutil.Diff(expected, printed))
+	}
+	result = graphql.Do(graphql.Params{
+		Schema:        preferLast,
+		RequestString: `{ item(id: "i2") { id } }`,
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{"item": map[string]any{"id": "i2"}}, result.Data)
+}
@@ -0,0 +1,182 @@
+package graphql_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+var oneOfTestInputObject = graphql.NewInputObject(graphql.InputObjectConfig{
+	Name:    "PetInput",
+	IsOneOf: true,
+	Fields: graphql.InputObjectConfigFieldMap{
+		"cat": &graphql.InputObjectFieldConfig{Type: graphql.String},
+		"dog": &graphql.InputObjectFieldConfig{Type: graphql.String},
+	},
+})
+
+var oneOfTestSchema, _ = graphql.NewSchema(graphql.SchemaConfig{
+	Query: graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"pet": &graphql.Field{
+				Type: graphql.String,
+				Args: graphql.FieldConfigArgument{
+					&graphql.ArgumentConfig{Name: "input", Type: oneOfTestInputObject},
+				},
+				Resolve: func(p graphql.ResolveParams) (any, error) {
+					input, ok := p.Args["input"]
+					if !ok {
+						return nil, nil
+					}
+					b, err := json.Marshal(input)
+					return string(b), err
+				},
+			},
+		},
+	}),
+})
+
+func TestOneOfInputObject_AcceptsExactlyOneField(t *testing.T) {
+	result := graphql.Do(graphql.Params{
+		Schema:         oneOfTestSchema,
+		RequestString:  `query ($dog: String!, $input: PetInput) { literal: pet(input: {cat: "Tom"}) variable: pet(input: {dog: $dog}) input: pet(input: $input) }`,
+		VariableValues: map[string]any{"dog": "Odie", "input": map[string]any{"cat": "Garfield"}},
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{
+		"literal":  `{"cat":"Tom"}`,
+		"variable": `{"dog":"Odie"}`,
+		"input":    `{"cat":"Garfield"}`,
+	}, result.Data)
+}
+
+func TestOneOfInputObject_RejectsInvalidVariableValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   map[string]any
+		message string
+	}{
+		{
+			name:    "no field",
+			input:   map[string]any{},
+			message: "Variable \"$input\" got invalid value {}.\nExactly one key must be specified for OneOf type \"PetInput\".",
+		},
+		{
+			name:    "two fields",
+			input:   map[string]any{"cat": "Tom", "dog": "Odie"},
+			message: "Variable \"$input\" got invalid value {\"cat\":\"Tom\",\"dog\":\"Odie\"}.\nExactly one key must be specified for OneOf type \"PetInput\".",
+		},
+		{
+			name:    "null field",
+			input:   map[string]any{"cat": nil},
+			message: "Variable \"$input\" got invalid value {\"cat\":null}.\nField \"PetInput.cat\" must be non-null.",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			result := graphql.Do(graphql.Params{
+				Schema:         oneOfTestSchema,
+				RequestString:  `query ($input: PetInput) { pet(input: $input) }`,
+				VariableValues: map[string]any{"input": test.input},
+			})
+			if assert.Len(t, result.Errors, 1) {
+				assert.Equal(t, test.message, result.Errors[0].Message)
+			}
+		})
+	}
+}
+
+func TestOneOfInputObject_DoesNotCoerceInvalidLiterals(t *testing.T) {
+	// execute without validation to reach argument coercion
+	result := testutil.TestExecute(t, graphql.ExecuteParams{
+		Schema: oneOfTestSchema,
+		AST:    testutil.TestParse(t, `{ two: pet(input: {cat: "Tom", dog: "Odie"}) null: pet(input: {cat: null}) }`),
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{"two": nil, "null": nil}, result.Data)
+}
+
+func TestOneOfInputObject_RequiresNullableFieldsWithoutDefaults(t *testing.T) {
+	tests := []struct {
+		field   *graphql.InputObjectFieldConfig
+		message string
+	}{
+		{
+			field:   &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(graphql.String)},
+			message: "OneOf input field PetInput.cat must be nullable.",
+		},
+		{
+			field:   &graphql.InputObjectFieldConfig{Type: graphql.String, DefaultValue: "Tom"},
+			message: "OneOf input field PetInput.cat cannot have a default value.",
+		},
+	}
+	for _, test := range tests {
+		input := graphql.NewInputObject(graphql.InputObjectConfig{
+			Name:    "PetInput",
+			IsOneOf: true,
+			Fields:  graphql.InputObjectConfigFieldMap{"cat": test.field},
+		})
+		_, err := graphql.NewSchema(graphql.SchemaConfig{
+			Query: graphql.NewObject(graphql.ObjectConfig{
+				Name: "Query",
+				Fields: graphql.Fields{
+					"pet": &graphql.Field{
+						Type: graphql.String,
+						Args: graphql.FieldConfigArgument{
+							&graphql.ArgumentConfig{Name: "input", Type: input},
+						},
+					},
+				},
+			}),
+		})
+		assert.EqualError(t, err, test.message)
+	}
+}
+
+func TestOneOfInputObject_IsExposedThroughIntrospectionAndSDL(t *testing.T) {
+	sdl := `input PetFilter {
+  name: String
+}
+
+input PetInput @oneOf {
+  cat: String
+  dog: String
+}
+
+type Query {
+  pet(input: PetInput, filter: PetFilter): String
+}`
+	schema, err := graphql.BuildSchema(sdl, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if printed := graphql.PrintSchema(schema); printed != sdl {
+		t.Fatalf("Unexpected SDL, Diff: %v", testutil.Diff(sdl, printed))
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema: schema,
+		RequestString: `{
+			input: __type(name: "PetInput") { isOneOf }
+			filter: __type(name: "PetFilter") { isOneOf }
+			query: __type(name: "Query") { isOneOf }
+		}`,
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{
+		"input":  map[string]any{"isOneOf": true},
+		"filter": map[string]any{"isOneOf": false},
+		"query":  map[string]any{"isOneOf": nil},
+	}, result.Data)
+
+	clientSchema, err := graphql.BuildClientSchema(introspect(t, schema))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.True(t, clientSchema.Type("PetInput").(*graphql.InputObject).IsOneOf())
+	assert.Equal(t, sdl, graphql.PrintSchema(clientSchema))
+}
@@ -0,0 +1,126 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/stretchr/testify/assert"
+)
+
+func optionalArgsTestSchema(t *testing.T, resolve graphql.FieldResolveFn) graphql.Schema {
+	patch := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Patch",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"name":  &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"email": &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"role":  &graphql.InputObjectFieldConfig{Type: graphql.String, DefaultValue: "user"},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"update": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						&graphql.ArgumentConfig{Name: "name", Type: graphql.String},
+						&graphql.ArgumentConfig{Name: "email", Type: graphql.String},
+						&graphql.ArgumentConfig{Name: "limit", Type: graphql.Int, DefaultValue: 10},
+						&graphql.ArgumentConfig{Name: "patch", Type: patch},
+						&graphql.ArgumentConfig{Name: "tags", Type: graphql.NewList(graphql.String)},
+					},
+					Resolve: resolve,
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return schema
+}
+
+func TestArgs_DistinguishAbsentFromNull(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		variables map[string]any
+		expected  map[string]any
+	}{
+		{
+			name:     "literals",
+			query:    `{ update(name: null, limit: null, patch: { email: null }) }`,
+			expected: map[string]any{"name": nil, "limit": nil, "patch": map[string]any{"email": nil, "role": "user"}},
+		},
+		{
+			name:     "defaults",
+			query:    `{ update(patch: {}) }`,
+			expected: map[string]any{"limit": 10, "patch": map[string]any{"role": "user"}},
+		},
+		{
+			name:      "variables",
+			query:     `query ($name: String, $email: String, $role: String) { update(name: $name, email: $email, patch: { name: $name, email: $email, role: $role }, tags: [$email]) }`,
+			variables: map[string]any{"name": nil},
+			expected: map[string]any{
+				"name":  nil,
+				"limit": 10,
+				"patch": map[string]any{"name": nil, "role": "user"},
+				"tags":  []any{nil},
+			},
+		},
+		{
+			name:      "variable defaults",
+			query:     `query ($name: String = "ada", $limit: Int = 5) { update(name: $name, limit: $limit) }`,
+			variables: map[string]any{"name": nil},
+			expected:  map[string]any{"name": nil, "limit": int64(5)},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var args map[string]any
+			result := graphql.Do(graphql.Params{
+				Schema: optionalArgsTestSchema(t, func(p graphql.ResolveParams) (any, error) {
+					args = p.Args
+					return nil, nil
+				}),
+				RequestString:  test.query,
+				VariableValues: test.variables,
+			})
+			assert.Empty(t, result.Errors)
+			assert.Equal(t, test.expected, args)
+		})
+	}
+}
+
+func TestArgs_DecodeOptionalValues(t *testing.T) {
+	type patch struct {
+		Name  graphql.Optional[*string]
+		Email graphql.Optional[string]
+		Role  graphql.Optional[string]
+	}
+	var args struct {
+		Name  graphql.Optional[string]
+		Email graphql.Optional[string]
+		Limit graphql.Optional[int]
+		Patch graphql.Optional[patch]
+	}
+	result := graphql.Do(graphql.Params{
+		Schema: optionalArgsTestSchema(t, func(p graphql.ResolveParams) (any, error) {
+			return nil, p.DecodeArgs(&args)
+		}),
+		RequestString: `{ update(email: null, patch: { name: "ada" }) }`,
+	})
+	assert.Empty(t, result.Errors)
+
+	name := "ada"
+	assert.Equal(t, graphql.Optional[string]{}, args.Name)
+	assert.Equal(t, graphql.Optional[string]{Set: true, Null: true}, args.Email)
+	assert.Equal(t, graphql.Optional[int]{Value: 10, Set: true}, args.Limit)
+	assert.Equal(t, graphql.Optional[patch]{
+		Value: patch{
+			Name: graphql.Optional[*string]{Value: &name, Set: true},
+			Role: graphql.Optional[string]{Value: "user", Set: true},
+		},
+		Set: true,
+	}, args.Patch)
+}
@@ -0,0 +1,213 @@
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// OrderedMap is a map of string keys to values which keeps the keys in the
+// order they were first set. With ExecuteParams.OrderedData, the executor
+// returns the data of results as ordered maps, so the fields are in the order
+// of the selection set when the result is encoded to JSON, as the
+// specification requires.
+//
+// Values of fields of object types are ordered maps as well, values of fields
+// of list types are []any.
+type OrderedMap struct {
+	keys   []string
+	values map[string]any
+}
+
+// NewOrderedMap returns an empty ordered map.
+func NewOrderedMap() *OrderedMap {
+	return newOrderedMap(0)
+}
+
+func newOrderedMap(size int) *OrderedMap {
+	return &OrderedMap{
+		keys:   make([]string, 0, size),
+		values: make(map[string]any, size),
+	}
+}
+
+// Len returns the number of keys in the map.
+func (m *OrderedMap) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.keys)
+}
+
+// Keys returns the keys of the map in order.
+func (m *OrderedMap) Keys() []string {
+	if m == nil {
+		return nil
+	}
+	return append([]string{}, m.keys...)
+}
+
+// Get returns the value of the key and whether the map contains the key.
+func (m *OrderedMap) Get(key string) (any, bool) {
+	if m == nil {
+		return nil, false
+	}
+	value, ok := m.values[key]
+	return value, ok
+}
+
+// Set sets the value of the key. New keys are added after the existing keys,
+// existing keys keep their position. A nil map stays empty.
+func (m *OrderedMap) Set(key string, value any) {
+	if m == nil {
+		return
+	}
+	if m.values == nil {
+		m.values = map[string]any{}
+	}
+	if _, ok := m.values[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+// Delete removes the key from the map.
+func (m *OrderedMap) Delete(key string) {
+	if m == nil {
+		return
+	}
+	if _, ok := m.values[key]; !ok {
+		return
+	}
+	delete(m.values, key)
+	for i, k := range m.keys {
+		if k == key {
+			m.keys = append(m.keys[:i], m.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// Range calls fn for each key and value of the map in order, until fn returns
+// false.
+func (m *OrderedMap) Range(fn func(key string, value any) bool) {
+	if m == nil {
+		return
+	}
+	for _, key := range m.keys {
+		if !fn(key, m.values[key]) {
+			return
+		}
+	}
+}
+
+// Map returns the values of the map as map[string]any, converting nested
+// ordered maps, also within lists, to map[string]any as well. It serves code
+// walking results as plain maps.
+func (m *OrderedMap) Map() map[string]any {
+	if m == nil {
+		return nil
+	}
+	values := make(map[string]any, len(m.keys))
+	for _, key := range m.keys {
+		values[key] = plainValue(m.values[key])
+	}
+	return values
+}
+
+func plainValue(value any) any {
+	switch value := value.(type) {
+	case *OrderedMap:
+		return value.Map()
+	case []any:
+		values := make([]any, len(value))
+		for i, item := range value {
+			values[i] = plainValue(item)
+		}
+		return values
+	}
+	return value
+}
+
+// unorderedValue returns the value with ordered maps, also within lists,
+// replaced by their plain maps. Unlike Map, it reuses the maps and lists of the
+// value instead of copying them.
+func unorderedValue(value any) any {
+	switch value := value.(type) {
+	case *OrderedMap:
+		if value == nil {
+			return nil
+		}
+		for key, item := range value.values {
+			value.values[key] = unorderedValue(item)
+		}
+		if value.values == nil {
+			return map[string]any{}
+		}
+		return value.values
+	case []any:
+		for i, item := range value {
+			value[i] = unorderedValue(item)
+		}
+		return value
+	}
+	return value
+}
+
+// MarshalJSON encodes the map as JSON object with the keys in order.
+func (m *OrderedMap) MarshalJSON() ([]byte, error) {
+	buf := bytes.Buffer{}
+	if err := writeJSON(&buf, m); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// writeJSON writes the JSON encoding of value to buf. Ordered maps and lists
+// are written directly, so that encoding/json doesn't compact the output of
+// MarshalJSON again for every level of nesting.
+func writeJSON(buf *bytes.Buffer, value any) error {
+	switch value := value.(type) {
+	case *OrderedMap:
+		if value == nil {
+			buf.WriteString("null")
+			return nil
+		}
+		buf.WriteByte('{')
+		for i, key := range value.keys {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeJSON(buf, key); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			if err := writeJSON(buf, value.values[key]); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+		return nil
+	case []any:
+		if value == nil {
+			buf.WriteString("null")
+			return nil
+		}
+		buf.WriteByte('[')
+		for i, item := range value {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeJSON(buf, item); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	buf.Write(data)
+	return nil
+}
@@ -0,0 +1,190 @@
+package graphql_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/language/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderedMap_KeepsKeysInOrder(t *testing.T) {
+	m := graphql.NewOrderedMap()
+	m.Set("b", 1)
+	m.Set("a", 2)
+	m.Set("c", 3)
+	m.Set("b", 4)
+	assert.Equal(t, []string{"b", "a", "c"}, m.Keys())
+	assert.Equal(t, 3, m.Len())
+
+	value, ok := m.Get("b")
+	assert.True(t, ok)
+	assert.Equal(t, 4, value)
+	_, ok = m.Get("d")
+	assert.False(t, ok)
+
+	m.Delete("a")
+	m.Delete("d")
+	assert.Equal(t, []string{"b", "c"}, m.Keys())
+
+	keys := []string{}
+	m.Range(func(key string, value any) bool {
+		keys = append(keys, key)
+		return false
+	})
+	assert.Equal(t, []string{"b"}, keys)
+
+	var empty *graphql.OrderedMap
+	empty.Set("a", 1)
+	empty.Delete("a")
+	assert.Equal(t, 0, empty.Len())
+	assert.Nil(t, empty.Keys())
+	assert.Nil(t, empty.Map())
+}
+
+func TestOrderedMap_ConvertsToPlainMaps(t *testing.T) {
+	nested := graphql.NewOrderedMap()
+	nested.Set("name", "Odie")
+	m := graphql.NewOrderedMap()
+	m.Set("pet", nested)
+	m.Set("pets", []any{nested, nil})
+	m.Set("count", 2)
+
+	assert.Equal(t, map[string]any{
+		"pet":   map[string]any{"name": "Odie"},
+		"pets":  []any{map[string]any{"name": "Odie"}, nil},
+		"count": 2,
+	}, m.Map())
+}
+
+func TestOrderedMap_MarshalsJSONInOrder(t *testing.T) {
+	nested := graphql.NewOrderedMap()
+	nested.Set("z", []any{int64(1), "<a>", nil})
+	nested.Set("y", map[string]any{"b": true, "a": false})
+	m := graphql.NewOrderedMap()
+	m.Set("second", nested)
+	m.Set("first", (*graphql.OrderedMap)(nil))
+	m.Set("empty", graphql.NewOrderedMap())
+
+	b, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.Equal(t, `{"second":{"z":[1,"\u003ca\u003e",null],"y":{"a":false,"b":true}},"first":null,"empty":{}}`, string(b))
+
+	m.Set("invalid", make(chan int))
+	_, err = json.Marshal(m)
+	assert.Error(t, err)
+}
+
+var orderedMapTestPetType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "Pet",
+	Fields: graphql.Fields{
+		"name":  &graphql.Field{Type: graphql.String},
+		"kind":  &graphql.Field{Type: graphql.String},
+		"owner": &graphql.Field{Type: graphql.String},
+	},
+})
+
+var orderedMapTestSchema, _ = graphql.NewSchema(graphql.SchemaConfig{
+	Query: graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"pet":  &graphql.Field{Type: orderedMapTestPetType},
+			"pets": &graphql.Field{Type: graphql.NewList(orderedMapTestPetType)},
+		},
+	}),
+	Mutation: graphql.NewObject(graphql.ObjectConfig{
+		Name: "Mutation",
+		Fields: graphql.Fields{
+			"rename": &graphql.Field{Type: orderedMapTestPetType},
+			"adopt":  &graphql.Field{Type: orderedMapTestPetType},
+		},
+	}),
+})
+
+func executeOrderedMapTest(t *testing.T, query string, options parser.ParseOptions) string {
+	doc, err := parser.Parse(parser.ParseParams{Source: query, Options: options})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pet := map[string]any{"name": "Odie", "kind": "dog", "owner": "Jon"}
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema: orderedMapTestSchema,
+		AST:    doc,
+		Root: map[string]any{
+			"pet":    pet,
+			"pets":   []any{pet, pet},
+			"rename": pet,
+			"adopt":  pet,
+		},
+		OrderedData: true,
+	})
+	assert.Empty(t, result.Errors)
+	b, err := json.Marshal(result)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return string(b)
+}
+
+func TestExecute_OrdersFieldsLikeTheSelectionSet(t *testing.T) {
+	for name, test := range map[string]struct {
+		query    string
+		expected string
+	}{
+		"nested objects and lists": {
+			query:    `{ pets { owner name } pet { kind name } }`,
+			expected: `{"data":{"pets":[{"owner":"Jon","name":"Odie"},{"owner":"Jon","name":"Odie"}],"pet":{"kind":"dog","name":"Odie"}}}`,
+		},
+		"aliases and merged fields": {
+			query:    `{ pet { owner a: name kind owner } b: pet { name } }`,
+			expected: `{"data":{"pet":{"owner":"Jon","a":"Odie","kind":"dog"},"b":{"name":"Odie"}}}`,
+		},
+		"fragments defined after their use": {
+			query:    `{ pet { ...Names kind ... on Pet { owner } } } fragment Names on Pet { name }`,
+			expected: `{"data":{"pet":{"name":"Odie","kind":"dog","owner":"Jon"}}}`,
+		},
+		"fragment spreads before fields of the fragment": {
+			query:    `{ pet { kind ...Names } } fragment Names on Pet { owner kind name }`,
+			expected: `{"data":{"pet":{"kind":"dog","owner":"Jon","name":"Odie"}}}`,
+		},
+		"skipped fields": {
+			query:    `{ pet { kind @skip(if: true) name kind } }`,
+			expected: `{"data":{"pet":{"name":"Odie","kind":"dog"}}}`,
+		},
+		"mutations": {
+			query:    `mutation { rename { name } adopt { owner } }`,
+			expected: `{"data":{"rename":{"name":"Odie"},"adopt":{"owner":"Jon"}}}`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, executeOrderedMapTest(t, test.query, parser.ParseOptions{}))
+		})
+	}
+}
+
+func TestExecute_OrdersFieldsOfDocumentsWithoutLocations(t *testing.T) {
+	options := parser.ParseOptions{NoLocation: true}
+	assert.Equal(t,
+		`{"data":{"pet":{"owner":"Jon","name":"Odie"}}}`,
+		executeOrderedMapTest(t, `{ pet { owner name } }`, options),
+	)
+	assert.Equal(t,
+		`{"data":{"adopt":{"kind":"dog"},"rename":{"name":"Odie"}}}`,
+		executeOrderedMapTest(t, `mutation { adopt { kind } rename { name } }`, options),
+	)
+}
+
+func TestExecute_ReturnsPlainMapsWithoutOrderedData(t *testing.T) {
+	pet := map[string]any{"name": "Odie", "kind": "dog"}
+	result := graphql.Do(graphql.Params{
+		Schema:        orderedMapTestSchema,
+		RequestString: `{ pets { name } pet { kind } }`,
+		RootObject:    map[string]any{"pet": pet, "pets": []any{pet}},
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{
+		"pets": []any{map[string]any{"name": "Odie"}},
+		"pet":  map[string]any{"kind": "dog"},
+	}, result.Data)
+}
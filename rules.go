@@ -1,6 +1,7 @@
 package graphql
 
 import (
+	"context"
 	"fmt"
 	"math"
 	"reflect"
@@ -29,11 +30,13 @@ var SpecifiedRules = []ValidationRuleFn{
 	NoUndefinedVariablesRule,
 	NoUnusedFragmentsRule,
 	NoUnusedVariablesRule,
+	OneOfInputObjectsRule,
 	OverlappingFieldsCanBeMergedRule,
 	PossibleFragmentSpreadsRule,
 	ProvidedNonNullArgumentsRule,
 	ScalarLeafsRule,
 	UniqueArgumentNamesRule,
+	UniqueDirectivesPerLocationRule,
 	UniqueFragmentNamesRule,
 	UniqueInputFieldNamesRule,
 	UniqueOperationNamesRule,
@@ -232,12 +235,12 @@ func FieldsOnCorrectTypeRule(context *ValidationContext) *ValidationRuleInstance
 								nodeName = node.Name.Value
 							}
 							// First determine if there are any suggested types to condition on.
-							suggestedTypeNames := getSuggestedTypeNames(context.Schema(), ttype, nodeName)
+							suggestedTypeNames := getSuggestedTypeNames(context.Context(), context.Schema(), ttype, nodeName)
 
 							// If there are no suggested types, then perhaps this was a typo?
 							suggestedFieldNames := []string{}
 							if len(suggestedTypeNames) == 0 {
-								suggestedFieldNames = getSuggestedFieldNames(ttype, nodeName)
+								suggestedFieldNames = getSuggestedFieldNames(context.Context(), ttype, nodeName)
 							}
 							reportError(
 								context,
@@ -260,7 +263,7 @@ func FieldsOnCorrectTypeRule(context *ValidationContext) *ValidationRuleInstance
 // that they implement. If any of those types include the provided field,
 // suggest them, sorted by how often the type is referenced,  starting
 // with Interfaces.
-func getSuggestedTypeNames(schema *Schema, ttype Output, fieldName string) []string {
+func getSuggestedTypeNames(ctx context.Context, schema *Schema, ttype Output, fieldName string) []string {
 	var (
 		suggestedObjectTypes = []string{}
 		suggestedInterfaces  = []*suggestedInterface{}
@@ -272,7 +275,7 @@ func getSuggestedTypeNames(schema *Schema, ttype Output, fieldName string) []str
 	possibleTypes := schema.PossibleTypes(ttype)
 
 	for _, possibleType := range possibleTypes {
-		if field, ok := possibleType.Fields()[fieldName]; !ok || field == nil {
+		if field, ok := possibleType.Fields()[fieldName]; !ok || field == nil || !isFieldVisible(ctx, possibleType, field) {
 			continue
 		}
 		// This object type defines this field.
@@ -280,7 +283,7 @@ func getSuggestedTypeNames(schema *Schema, ttype Output, fieldName string) []str
 		suggestedObjectMap[possibleType.Name()] = true
 
 		for _, possibleInterface := range possibleType.Interfaces() {
-			if field, ok := possibleInterface.Fields()[fieldName]; !ok || field == nil {
+			if field, ok := possibleInterface.Fields()[fieldName]; !ok || field == nil || !isFieldVisible(ctx, possibleInterface, field) {
 				continue
 			}
 
@@ -325,20 +328,25 @@ func getSuggestedTypeNames(schema *Schema, ttype Output, fieldName string) []str
 
 // getSuggestedFieldNames For the field name provided, determine if there are any similar field names
 // that may be the result of a typo.
-func getSuggestedFieldNames(ttype Output, fieldName string) []string {
-	var fields FieldDefinitionMap
+func getSuggestedFieldNames(ctx context.Context, ttype Output, fieldName string) []string {
+	var (
+		parentType Composite
+		fields     FieldDefinitionMap
+	)
 	switch ttype := ttype.(type) {
 	case *Object:
-		fields = ttype.Fields()
+		parentType, fields = ttype, ttype.Fields()
 	case *Interface:
-		fields = ttype.Fields()
+		parentType, fields = ttype, ttype.Fields()
 	default:
 		return []string{}
 	}
 
 	possibleFieldNames := []string{}
-	for possibleFieldName := range fields {
-		possibleFieldNames = append(possibleFieldNames, possibleFieldName)
+	for possibleFieldName, field := range fields {
+		if isFieldVisible(ctx, parentType, field) {
+			possibleFieldNames = append(possibleFieldNames, possibleFieldName)
+		}
 	}
 	return suggestionList(fieldName, possibleFieldNames)
 }
@@ -1133,6 +1141,83 @@ func NoUnusedVariablesRule(context *ValidationContext) *ValidationRuleInstance {
 	}
 }
 
+// OneOfInputObjectsRule OneOf input objects
+//
+// A GraphQL document is only valid if every OneOf input object literal gives
+// exactly one field a value, which is neither null nor a nullable variable.
+func OneOfInputObjectsRule(context *ValidationContext) *ValidationRuleInstance {
+	variableDefinitions := map[string]*ast.VariableDefinition{}
+
+	visitorOpts := &visitor.VisitorOptions{
+		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			kinds.OperationDefinition: {
+				Kind: func(p visitor.VisitFuncParams) (string, any) {
+					variableDefinitions = map[string]*ast.VariableDefinition{}
+					if node, ok := p.Node.(*ast.OperationDefinition); ok && node != nil {
+						for _, def := range node.VariableDefinitions {
+							if def.Variable != nil && def.Variable.Name != nil {
+								variableDefinitions[def.Variable.Name.Value] = def
+							}
+						}
+					}
+					return visitor.ActionNoChange, nil
+				},
+			},
+			kinds.ObjectValue: {
+				Kind: func(p visitor.VisitFuncParams) (string, any) {
+					node, ok := p.Node.(*ast.ObjectValue)
+					if !ok || node == nil {
+						return visitor.ActionNoChange, nil
+					}
+					ttype, ok := GetNamed(context.InputType()).(*InputObject)
+					if !ok || !ttype.IsOneOf() {
+						return visitor.ActionNoChange, nil
+					}
+					if len(node.Fields) != 1 {
+						return reportError(
+							context,
+							fmt.Sprintf(`OneOf Input Object "%v" must specify exactly one key.`, ttype.Name()),
+							[]ast.Node{node},
+						)
+					}
+					field := node.Fields[0]
+					if field == nil || field.Name == nil {
+						return visitor.ActionNoChange, nil
+					}
+					switch value := field.Value.(type) {
+					case *ast.NullValue:
+						return reportError(
+							context,
+							fmt.Sprintf(`Field "%v.%v" must be non-null.`, ttype.Name(), field.Name.Value),
+							[]ast.Node{node},
+						)
+					case *ast.Variable:
+						if value.Name == nil {
+							break
+						}
+						def, ok := variableDefinitions[value.Name.Value]
+						if !ok {
+							break
+						}
+						if _, isNonNull := def.Type.(*ast.NonNull); !isNonNull {
+							return reportError(
+								context,
+								fmt.Sprintf(`Variable "$%v" must be non-nullable to be used for OneOf Input Object "%v".`,
+									value.Name.Value, ttype.Name()),
+								[]ast.Node{node},
+							)
+						}
+					}
+					return visitor.ActionNoChange, nil
+				},
+			},
+		},
+	}
+	return &ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
+	}
+}
+
 func getFragmentType(context *ValidationContext, name string) Type {
 	frag := context.Fragment(name)
 	if frag == nil {
@@ -1424,6 +1509,95 @@ func UniqueArgumentNamesRule(context *ValidationContext) *ValidationRuleInstance
 	}
 }
 
+// UniqueDirectivesPerLocationRule Unique directive names per location
+//
+// A GraphQL document is only valid if all non-repeatable directives at
+// a given location are uniquely named.
+func UniqueDirectivesPerLocationRule(context *ValidationContext) *ValidationRuleInstance {
+	repeatableDirectives := map[string]bool{}
+	if schema := context.Schema(); schema != nil {
+		for _, directive := range schema.Directives() {
+			repeatableDirectives[directive.Name] = directive.IsRepeatable
+		}
+	}
+	if doc := context.Document(); doc != nil {
+		for _, def := range doc.Definitions {
+			if def, ok := def.(*ast.DirectiveDefinition); ok && def.Name != nil {
+				repeatableDirectives[def.Name.Value] = def.Repeatable
+			}
+		}
+	}
+
+	visitorOpts := &visitor.VisitorOptions{
+		Enter: func(p visitor.VisitFuncParams) (string, any) {
+			node, ok := p.Node.(ast.Node)
+			if !ok {
+				return visitor.ActionNoChange, nil
+			}
+			knownDirectives := map[string]*ast.Directive{}
+			for _, directive := range nodeDirectives(node) {
+				if directive == nil || directive.Name == nil {
+					continue
+				}
+				directiveName := directive.Name.Value
+				if repeatableDirectives[directiveName] {
+					continue
+				}
+				if seen, ok := knownDirectives[directiveName]; ok {
+					reportError(
+						context,
+						fmt.Sprintf(`The directive "@%v" can only be used once at this location.`, directiveName),
+						[]ast.Node{seen, directive},
+					)
+				} else {
+					knownDirectives[directiveName] = directive
+				}
+			}
+			return visitor.ActionNoChange, nil
+		},
+	}
+	return &ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
+	}
+}
+
+// nodeDirectives returns the directives applied to the given node, if any.
+func nodeDirectives(node ast.Node) []*ast.Directive {
+	switch node := node.(type) {
+	case *ast.OperationDefinition:
+		return node.Directives
+	case *ast.FragmentDefinition:
+		return node.Directives
+	case *ast.Field:
+		return node.Directives
+	case *ast.FragmentSpread:
+		return node.Directives
+	case *ast.InlineFragment:
+		return node.Directives
+	case *ast.SchemaDefinition:
+		return node.Directives
+	case *ast.ScalarDefinition:
+		return node.Directives
+	case *ast.ObjectDefinition:
+		return node.Directives
+	case *ast.FieldDefinition:
+		return node.Directives
+	case *ast.InputValueDefinition:
+		return node.Directives
+	case *ast.InterfaceDefinition:
+		return node.Directives
+	case *ast.UnionDefinition:
+		return node.Directives
+	case *ast.EnumDefinition:
+		return node.Directives
+	case *ast.EnumValueDefinition:
+		return node.Directives
+	case *ast.InputObjectDefinition:
+		return node.Directives
+	}
+	return nil
+}
+
 // UniqueFragmentNamesRule Unique fragment names
 //
 // A GraphQL document is only valid if all defined fragments have unique names.
@@ -0,0 +1,119 @@
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/fraym/graphql-go/language/ast"
+	"github.com/fraym/graphql-go/language/kinds"
+	"github.com/fraym/graphql-go/language/visitor"
+)
+
+// NoDeprecatedCustomRule No deprecated
+//
+// A GraphQL document is only valid if all selected fields and all used
+// arguments, input fields and enum values have not been deprecated.
+//
+// This rule is not part of SpecifiedRules. Add it to Params.WarningRules to
+// report usage of deprecated schema elements without failing the request.
+func NoDeprecatedCustomRule(context *ValidationContext) *ValidationRuleInstance {
+	visitorOpts := &visitor.VisitorOptions{
+		KindFuncMap: map[string]visitor.NamedVisitFuncs{
+			kinds.Field: {
+				Kind: func(p visitor.VisitFuncParams) (string, any) {
+					fieldDef := context.FieldDef()
+					parentType := context.ParentType()
+					if fieldDef == nil || parentType == nil || fieldDef.DeprecationReason == "" {
+						return visitor.ActionNoChange, nil
+					}
+					return reportError(
+						context,
+						fmt.Sprintf(`The field %v.%v is deprecated. %v`,
+							parentType.Name(), fieldDef.Name, fieldDef.DeprecationReason),
+						[]ast.Node{p.Node.(ast.Node)},
+					)
+				},
+			},
+			kinds.Argument: {
+				Kind: func(p visitor.VisitFuncParams) (string, any) {
+					argDef := context.Argument()
+					if argDef == nil || argDef.DeprecationReason == "" {
+						return visitor.ActionNoChange, nil
+					}
+					if directive := context.Directive(); directive != nil {
+						return reportError(
+							context,
+							fmt.Sprintf(`Directive "@%v" argument "%v" is deprecated. %v`,
+								directive.Name, argDef.Name(), argDef.DeprecationReason),
+							[]ast.Node{p.Node.(ast.Node)},
+						)
+					}
+					fieldDef := context.FieldDef()
+					parentType := context.ParentType()
+					if fieldDef == nil || parentType == nil {
+						return visitor.ActionNoChange, nil
+					}
+					return reportError(
+						context,
+						fmt.Sprintf(`Field "%v.%v" argument "%v" is deprecated. %v`,
+							parentType.Name(), fieldDef.Name, argDef.Name(), argDef.DeprecationReason),
+						[]ast.Node{p.Node.(ast.Node)},
+					)
+				},
+			},
+			kinds.ObjectValue: {
+				Kind: func(p visitor.VisitFuncParams) (string, any) {
+					node, ok := p.Node.(*ast.ObjectValue)
+					if !ok || node == nil {
+						return visitor.ActionNoChange, nil
+					}
+					ttype, ok := GetNamed(context.InputType()).(*InputObject)
+					if !ok {
+						return visitor.ActionNoChange, nil
+					}
+					fields := ttype.Fields()
+					for _, fieldAST := range node.Fields {
+						if fieldAST == nil || fieldAST.Name == nil {
+							continue
+						}
+						field, ok := fields[fieldAST.Name.Value]
+						if !ok || field.DeprecationReason == "" {
+							continue
+						}
+						reportError(
+							context,
+							fmt.Sprintf(`The input field %v.%v is deprecated. %v`,
+								ttype.Name(), field.Name(), field.DeprecationReason),
+							[]ast.Node{fieldAST},
+						)
+					}
+					return visitor.ActionNoChange, nil
+				},
+			},
+			kinds.EnumValue: {
+				Kind: func(p visitor.VisitFuncParams) (string, any) {
+					node, ok := p.Node.(*ast.EnumValue)
+					if !ok || node == nil {
+						return visitor.ActionNoChange, nil
+					}
+					ttype, ok := GetNamed(context.InputType()).(*Enum)
+					if !ok {
+						return visitor.ActionNoChange, nil
+					}
+					value, ok := ttype.getNameLookup()[node.Value]
+					if !ok || value.DeprecationReason == "" {
+						return visitor.ActionNoChange, nil
+					}
+					return reportError(
+						context,
+						fmt.Sprintf(`The enum value "%v.%v" is deprecated. %v`,
+							ttype.Name(), value.Name, value.DeprecationReason),
+						[]ast.Node{node},
+					)
+				},
+			},
+		},
+	}
+	return &ValidationRuleInstance{
+		VisitorOpts: visitorOpts,
+	}
+}
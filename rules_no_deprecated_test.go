@@ -0,0 +1,76 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/gqlerrors"
+	"github.com/fraym/graphql-go/testutil"
+)
+
+const noDeprecatedTestSDL = `
+directive @cache(ttl: Int, maxAge: Int @deprecated(reason: "Use ttl.")) on FIELD
+
+enum Color {
+  RED
+  BLUE @deprecated(reason: "Use RED.")
+}
+
+input PetFilter {
+  name: String
+  nick: String @deprecated(reason: "Use name.")
+}
+
+type Query {
+  pet(filter: PetFilter, color: Color, id: ID @deprecated(reason: "Use filter.")): String
+  oldPet: String @deprecated(reason: "Use pet.")
+}
+`
+
+func noDeprecatedTestSchema(t *testing.T) *graphql.Schema {
+	schema, err := graphql.BuildSchema(noDeprecatedTestSDL, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return &schema
+}
+
+func TestValidate_NoDeprecated_IgnoresNonDeprecatedUsage(t *testing.T) {
+	testutil.ExpectPassesRuleWithSchema(t, noDeprecatedTestSchema(t), graphql.NoDeprecatedCustomRule, `
+      {
+        pet(filter: { name: "Odie" }, color: RED) @cache(ttl: 10)
+      }
+    `)
+}
+
+func TestValidate_NoDeprecated_ReportsDeprecatedField(t *testing.T) {
+	testutil.ExpectFailsRuleWithSchema(t, noDeprecatedTestSchema(t), graphql.NoDeprecatedCustomRule, `
+      {
+        oldPet
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`The field Query.oldPet is deprecated. Use pet.`, 3, 9),
+	})
+}
+
+func TestValidate_NoDeprecated_ReportsDeprecatedArguments(t *testing.T) {
+	testutil.ExpectFailsRuleWithSchema(t, noDeprecatedTestSchema(t), graphql.NoDeprecatedCustomRule, `
+      {
+        pet(id: 1) @cache(maxAge: 10)
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Field "Query.pet" argument "id" is deprecated. Use filter.`, 3, 13),
+		testutil.RuleError(`Directive "@cache" argument "maxAge" is deprecated. Use ttl.`, 3, 27),
+	})
+}
+
+func TestValidate_NoDeprecated_ReportsDeprecatedInputFieldsAndEnumValues(t *testing.T) {
+	testutil.ExpectFailsRuleWithSchema(t, noDeprecatedTestSchema(t), graphql.NoDeprecatedCustomRule, `
+      {
+        pet(filter: { nick: "Odie" }, color: BLUE)
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`The input field PetFilter.nick is deprecated. Use name.`, 3, 23),
+		testutil.RuleError(`The enum value "Color.BLUE" is deprecated. Use RED.`, 3, 46),
+	})
+}
@@ -0,0 +1,87 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/gqlerrors"
+	"github.com/fraym/graphql-go/testutil"
+)
+
+func TestValidate_OneOfInputObjects_ExactlyOneField(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.OneOfInputObjectsRule, `
+      {
+        complicatedArgs {
+          oneOfArgField(oneOfArg: { stringField: "abc" })
+        }
+      }
+    `)
+}
+
+func TestValidate_OneOfInputObjects_ExactlyOneNonNullableVariable(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.OneOfInputObjectsRule, `
+      query ($string: String!) {
+        complicatedArgs {
+          oneOfArgField(oneOfArg: { stringField: $string })
+        }
+      }
+    `)
+}
+
+func TestValidate_OneOfInputObjects_IgnoresOtherInputObjects(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.OneOfInputObjectsRule, `
+      {
+        complicatedArgs {
+          complexArgField(complexArg: { requiredField: true, intField: 4 })
+        }
+      }
+    `)
+}
+
+func TestValidate_OneOfInputObjects_MoreThanOneField(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.OneOfInputObjectsRule, `
+      {
+        complicatedArgs {
+          oneOfArgField(oneOfArg: { stringField: "abc", intField: 123 })
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`OneOf Input Object "OneOfInput" must specify exactly one key.`, 4, 35),
+	})
+}
+
+func TestValidate_OneOfInputObjects_NoFields(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.OneOfInputObjectsRule, `
+      {
+        complicatedArgs {
+          oneOfArgField(oneOfArg: {})
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`OneOf Input Object "OneOfInput" must specify exactly one key.`, 4, 35),
+	})
+}
+
+func TestValidate_OneOfInputObjects_NullField(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.OneOfInputObjectsRule, `
+      {
+        complicatedArgs {
+          oneOfArgField(oneOfArg: { stringField: null })
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Field "OneOfInput.stringField" must be non-null.`, 4, 35),
+	})
+}
+
+func TestValidate_OneOfInputObjects_NullableVariable(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.OneOfInputObjectsRule, `
+      query ($string: String) {
+        complicatedArgs {
+          oneOfArgField(oneOfArg: { stringField: $string })
+        }
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Variable "$string" must be non-nullable to be used for OneOf Input Object "OneOfInput".`, 4, 35),
+	})
+}
@@ -937,3 +937,24 @@ func TestValidate_OverlappingFieldsCanBeMerged_ReturnTypesMustBeUnambiguous_Igno
 func TestValidate_OverlappingFieldsCanBeMerged_NilCrash(t *testing.T) {
 	testutil.ExpectPassesRule(t, graphql.OverlappingFieldsCanBeMergedRule, `subscription {e}`)
 }
+
+func TestValidate_OverlappingFieldsCanBeMerged_ConflictingFieldsOnInheritedInterfaces(t *testing.T) {
+	// Image implements Resource, so both fragments may apply to the same object.
+	testutil.ExpectFailsRuleWithSchema(t, interfaceInheritanceSchema(t), graphql.OverlappingFieldsCanBeMergedRule, `
+        {
+          node {
+            ... on Resource {
+              url
+            }
+            ... on Image {
+              url: id
+            }
+          }
+        }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Fields "url" conflict because url and id are different fields. `+
+			`Use different aliases on the fields to fetch both if this was intentional.`,
+			5, 15,
+			8, 15),
+	})
+}
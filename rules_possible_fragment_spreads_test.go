@@ -196,3 +196,72 @@ func TestValidate_PossibleFragmentSpreads_InterfaceIntoNonOverlappingUnion(t *te
 			`type "HumanOrAlien" can never be of type "Pet".`, 2, 62),
 	})
 }
+
+const interfaceInheritanceSDL = `
+interface Node {
+  id: ID!
+}
+
+interface Resource implements Node {
+  id: ID!
+  url: String
+}
+
+interface Image implements Resource & Node {
+  id: ID!
+  url: String
+  width: Int
+}
+
+type File implements Resource & Node {
+  id: ID!
+  url: String
+  size: Int
+}
+
+type Photo implements Image & Resource & Node {
+  id: ID!
+  url: String
+  width: Int
+}
+
+type Folder implements Node {
+  id: ID!
+}
+
+type Query {
+  node: Node
+  resource: Resource
+}
+`
+
+func interfaceInheritanceSchema(t *testing.T) *graphql.Schema {
+	schema, err := graphql.BuildSchema(interfaceInheritanceSDL, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return &schema
+}
+
+func TestValidate_PossibleFragmentSpreads_InterfaceIntoImplementedInterface(t *testing.T) {
+	testutil.ExpectPassesRuleWithSchema(t, interfaceInheritanceSchema(t), graphql.PossibleFragmentSpreadsRule, `
+      fragment interfaceWithinInterface on Node { ...resourceFragment }
+      fragment resourceFragment on Resource { url }
+    `)
+}
+
+func TestValidate_PossibleFragmentSpreads_InterfaceIntoImplementingInterfaceInInlineFragment(t *testing.T) {
+	testutil.ExpectPassesRuleWithSchema(t, interfaceInheritanceSchema(t), graphql.PossibleFragmentSpreadsRule, `
+      fragment interfaceWithinInterfaceAnon on Resource { ... on Image { width } }
+    `)
+}
+
+func TestValidate_PossibleFragmentSpreads_ObjectIntoNotImplementingInheritedInterface(t *testing.T) {
+	testutil.ExpectFailsRuleWithSchema(t, interfaceInheritanceSchema(t), graphql.PossibleFragmentSpreadsRule, `
+      fragment invalidObjectWithinInterface on Image { ...fileFragment }
+      fragment fileFragment on File { size }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`Fragment "fileFragment" cannot be spread here as objects of `+
+			`type "Image" can never be of type "File".`, 2, 56),
+	})
+}
@@ -0,0 +1,135 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/gqlerrors"
+	"github.com/fraym/graphql-go/testutil"
+)
+
+func TestValidate_UniqueDirectivesPerLocation_NoDirectives(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.UniqueDirectivesPerLocationRule, `
+      fragment Test on Type {
+        field
+      }
+    `)
+}
+
+func TestValidate_UniqueDirectivesPerLocation_UniqueDirectivesInDifferentLocations(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.UniqueDirectivesPerLocationRule, `
+      fragment Test on Type @directiveA {
+        field @directiveB
+      }
+    `)
+}
+
+func TestValidate_UniqueDirectivesPerLocation_UniqueDirectivesInSameLocations(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.UniqueDirectivesPerLocationRule, `
+      fragment Test on Type @directiveA @directiveB {
+        field @directiveA @directiveB
+      }
+    `)
+}
+
+func TestValidate_UniqueDirectivesPerLocation_SameDirectivesInDifferentLocations(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.UniqueDirectivesPerLocationRule, `
+      fragment Test on Type @directiveA {
+        field @directiveA
+      }
+    `)
+}
+
+func TestValidate_UniqueDirectivesPerLocation_SameDirectivesInSimilarLocations(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.UniqueDirectivesPerLocationRule, `
+      fragment Test on Type {
+        field @directive
+        field @directive
+      }
+    `)
+}
+
+func TestValidate_UniqueDirectivesPerLocation_RepeatableDirectivesInSameLocation(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.UniqueDirectivesPerLocationRule, `
+      query Test @repeatable @repeatable {
+        field @repeatable @repeatable
+        ... @repeatable @repeatable {
+          field
+        }
+      }
+    `)
+}
+
+func TestValidate_UniqueDirectivesPerLocation_RepeatableDirectivesDefinedInDocument(t *testing.T) {
+	testutil.ExpectPassesRule(t, graphql.UniqueDirectivesPerLocationRule, `
+      directive @tag repeatable on OBJECT
+
+      type Dog @tag @tag {
+        name: String
+      }
+    `)
+}
+
+func TestValidate_UniqueDirectivesPerLocation_DuplicateDirectivesInOneLocation(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.UniqueDirectivesPerLocationRule, `
+      fragment Test on Type {
+        field @directive @directive
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`The directive "@directive" can only be used once at this location.`, 3, 15, 3, 26),
+	})
+}
+
+func TestValidate_UniqueDirectivesPerLocation_ManyDuplicateDirectivesInOneLocation(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.UniqueDirectivesPerLocationRule, `
+      fragment Test on Type {
+        field @directive @directive @directive
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`The directive "@directive" can only be used once at this location.`, 3, 15, 3, 26),
+		testutil.RuleError(`The directive "@directive" can only be used once at this location.`, 3, 15, 3, 37),
+	})
+}
+
+func TestValidate_UniqueDirectivesPerLocation_DifferentDuplicateDirectivesInOneLocation(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.UniqueDirectivesPerLocationRule, `
+      fragment Test on Type {
+        field @directiveA @directiveB @directiveA @directiveB
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`The directive "@directiveA" can only be used once at this location.`, 3, 15, 3, 39),
+		testutil.RuleError(`The directive "@directiveB" can only be used once at this location.`, 3, 27, 3, 51),
+	})
+}
+
+func TestValidate_UniqueDirectivesPerLocation_DuplicateDirectivesInManyLocations(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.UniqueDirectivesPerLocationRule, `
+      fragment Test on Type @directive @directive {
+        field @directive @directive
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`The directive "@directive" can only be used once at this location.`, 2, 29, 2, 40),
+		testutil.RuleError(`The directive "@directive" can only be used once at this location.`, 3, 15, 3, 26),
+	})
+}
+
+func TestValidate_UniqueDirectivesPerLocation_DuplicateIncludeDirectives(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.UniqueDirectivesPerLocationRule, `
+      {
+        field @include(if: true) @include(if: false)
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`The directive "@include" can only be used once at this location.`, 3, 15, 3, 34),
+	})
+}
+
+func TestValidate_UniqueDirectivesPerLocation_DuplicateDirectivesOnTypeDefinitions(t *testing.T) {
+	testutil.ExpectFailsRule(t, graphql.UniqueDirectivesPerLocationRule, `
+      type Dog @onObject @onObject {
+        name: String @onFieldDefinition @onFieldDefinition
+      }
+    `, []gqlerrors.FormattedError{
+		testutil.RuleError(`The directive "@onObject" can only be used once at this location.`, 2, 16, 2, 26),
+		testutil.RuleError(`The directive "@onFieldDefinition" can only be used once at this location.`, 3, 22, 3, 41),
+	})
+}
@@ -564,8 +564,9 @@ var DateTime = NewScalar(ScalarConfig{
 	Name: "DateTime",
 	Description: "The `DateTime` scalar type represents a DateTime." +
 		" The DateTime is serialized as an RFC 3339 quoted string",
-	Serialize:  serializeDateTime,
-	ParseValue: unserializeDateTime,
+	SpecifiedByURL: "https://scalars.graphql.org/andimarek/date-time",
+	Serialize:      serializeDateTime,
+	ParseValue:     unserializeDateTime,
 	ParseLiteral: func(valueAST ast.Value) (any, error) {
 		switch valueAST := valueAST.(type) {
 		case *ast.StringValue:
@@ -0,0 +1,190 @@
+package scalars
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/language/ast"
+)
+
+// Int64Config is the config of the Int64 scalar.
+var Int64Config = graphql.ScalarConfig{
+	Name: "Int64",
+	Description: "The `Int64` scalar type represents non-fractional signed whole numeric " +
+		"values between -(2^63) and 2^63 - 1.",
+	SpecifiedByURL: specificationURL + "#int64",
+	Serialize:      coerceInt64,
+	ParseValue:     coerceInt64,
+	ParseLiteral:   parseInt64Literal,
+}
+
+// Int64 represents signed 64 bit integers as int64 values.
+//
+// Values are serialized as numbers. Input values may be numbers or strings of
+// decimal digits, as JSON numbers beyond 2^53 can't be represented exactly by
+// many clients.
+var Int64 = graphql.NewScalar(Int64Config)
+
+// BigIntConfig is the config of the BigInt scalar.
+var BigIntConfig = graphql.ScalarConfig{
+	Name: "BigInt",
+	Description: "The `BigInt` scalar type represents non-fractional signed whole numeric " +
+		"values of arbitrary size, serialized as strings of decimal digits.",
+	SpecifiedByURL: specificationURL + "#bigint",
+	Serialize:      serializeBigInt,
+	ParseValue:     coerceBigInt,
+	ParseLiteral:   parseBigIntLiteral,
+}
+
+// BigInt represents integers of arbitrary size as *big.Int values.
+//
+// Values are serialized as strings of decimal digits. Input values may be
+// integral numbers or strings of decimal digits.
+var BigInt = graphql.NewScalar(BigIntConfig)
+
+func coerceInt64(value any) (any, error) {
+	switch value := indirect(value).(type) {
+	case int:
+		return int64(value), nil
+	case int8:
+		return int64(value), nil
+	case int16:
+		return int64(value), nil
+	case int32:
+		return int64(value), nil
+	case int64:
+		return value, nil
+	case uint:
+		return uintToInt64(uint64(value))
+	case uint8:
+		return int64(value), nil
+	case uint16:
+		return int64(value), nil
+	case uint32:
+		return int64(value), nil
+	case uint64:
+		return uintToInt64(value)
+	case float32:
+		return floatToInt64(float64(value))
+	case float64:
+		return floatToInt64(value)
+	case json.Number:
+		return stringToInt64(string(value))
+	case string:
+		return stringToInt64(value)
+	case big.Int:
+		if !value.IsInt64() {
+			return nil, fmt.Errorf("value %v is out of range for Int64", value.String())
+		}
+		return value.Int64(), nil
+	case nil:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("cannot coerce %T to Int64", value)
+	}
+}
+
+func uintToInt64(value uint64) (any, error) {
+	if value > math.MaxInt64 {
+		return nil, fmt.Errorf("value %v is out of range for Int64", value)
+	}
+	return int64(value), nil
+}
+
+func floatToInt64(value float64) (any, error) {
+	if value != math.Trunc(value) {
+		return nil, fmt.Errorf("value %v is not an integer", value)
+	}
+	// float64(math.MaxInt64) rounds up to 2^63
+	if value < math.MinInt64 || value >= math.MaxInt64 {
+		return nil, fmt.Errorf("value %v is out of range for Int64", value)
+	}
+	return int64(value), nil
+}
+
+func stringToInt64(value string) (any, error) {
+	i, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		if err.(*strconv.NumError).Err == strconv.ErrRange {
+			return nil, fmt.Errorf("value %v is out of range for Int64", value)
+		}
+		return nil, fmt.Errorf("value %q is not an integer", value)
+	}
+	return i, nil
+}
+
+func parseInt64Literal(valueAST ast.Value) (any, error) {
+	switch valueAST := valueAST.(type) {
+	case *ast.IntValue:
+		return stringToInt64(valueAST.Value)
+	case *ast.StringValue:
+		return stringToInt64(valueAST.Value)
+	case *ast.NullValue:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("cannot parse %T to Int64", valueAST)
+}
+
+func serializeBigInt(value any) (any, error) {
+	i, err := coerceBigInt(value)
+	if i, ok := i.(*big.Int); ok && err == nil {
+		return i.String(), nil
+	}
+	return nil, err
+}
+
+func coerceBigInt(value any) (any, error) {
+	switch value := indirect(value).(type) {
+	case big.Int:
+		return new(big.Int).Set(&value), nil
+	case int, int8, int16, int32, int64:
+		return big.NewInt(reflect.ValueOf(value).Int()), nil
+	case uint, uint8, uint16, uint32, uint64:
+		return new(big.Int).SetUint64(reflect.ValueOf(value).Uint()), nil
+	case float32:
+		return floatToBigInt(float64(value))
+	case float64:
+		return floatToBigInt(value)
+	case json.Number:
+		return stringToBigInt(string(value))
+	case string:
+		return stringToBigInt(value)
+	case nil:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("cannot coerce %T to BigInt", value)
+	}
+}
+
+func floatToBigInt(value float64) (any, error) {
+	if math.IsInf(value, 0) || value != math.Trunc(value) {
+		return nil, fmt.Errorf("value %v is not an integer", value)
+	}
+	i, _ := big.NewFloat(value).Int(nil)
+	return i, nil
+}
+
+func stringToBigInt(value string) (any, error) {
+	i, ok := new(big.Int).SetString(value, 10)
+	if !ok {
+		return nil, fmt.Errorf("value %q is not an integer", value)
+	}
+	return i, nil
+}
+
+func parseBigIntLiteral(valueAST ast.Value) (any, error) {
+	switch valueAST := valueAST.(type) {
+	case *ast.IntValue:
+		return stringToBigInt(valueAST.Value)
+	case *ast.StringValue:
+		return stringToBigInt(valueAST.Value)
+	case *ast.NullValue:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("cannot parse %T to BigInt", valueAST)
+}
@@ -0,0 +1,137 @@
+package scalars_test
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/fraym/graphql-go/scalars"
+)
+
+func TestInt64_Serialize(t *testing.T) {
+	i := 7
+	testScalarFn(t, "Int64.Serialize", scalars.Int64.Serialize, []scalarTest{
+		{nil, nil, false},
+		{(*int)(nil), nil, false},
+		{&i, int64(7), false},
+		{0, int64(0), false},
+		{int8(-8), int64(-8), false},
+		{int16(16), int64(16), false},
+		{int32(math.MinInt32), int64(math.MinInt32), false},
+		{int64(math.MaxInt64), int64(math.MaxInt64), false},
+		{int64(math.MinInt64), int64(math.MinInt64), false},
+		{uint(1), int64(1), false},
+		{uint8(math.MaxUint8), int64(math.MaxUint8), false},
+		{uint16(math.MaxUint16), int64(math.MaxUint16), false},
+		{uint32(math.MaxUint32), int64(math.MaxUint32), false},
+		{uint64(math.MaxInt64), int64(math.MaxInt64), false},
+		{uint64(math.MaxInt64 + 1), nil, true},
+		{uint(math.MaxUint64), nil, true},
+		{float32(1e5), int64(100000), false},
+		{float64(-1e15), int64(-1e15), false},
+		{float64(1.5), nil, true},
+		{math.Inf(1), nil, true},
+		{math.NaN(), nil, true},
+		{float64(math.MaxInt64), nil, true},
+		{float64(math.MinInt64), int64(math.MinInt64), false},
+		{"9223372036854775807", int64(math.MaxInt64), false},
+		{"-12", int64(-12), false},
+		{"9223372036854775808", nil, true},
+		{"1.0", nil, true},
+		{"one", nil, true},
+		{"", nil, true},
+		{json.Number("42"), int64(42), false},
+		{json.Number("4.2"), nil, true},
+		{big.NewInt(math.MaxInt64), int64(math.MaxInt64), false},
+		{new(big.Int).Lsh(big.NewInt(1), 63), nil, true},
+		{true, nil, true},
+		{[]int{1}, nil, true},
+	})
+}
+
+func TestInt64_ParseValue(t *testing.T) {
+	testScalarFn(t, "Int64.ParseValue", scalars.Int64.ParseValue, []scalarTest{
+		{nil, nil, false},
+		{float64(9007199254740993), int64(9007199254740992), false},
+		{"9007199254740993", int64(9007199254740993), false},
+		{float64(0.5), nil, true},
+		{"0x10", nil, true},
+		{false, nil, true},
+	})
+}
+
+func TestInt64_ParseLiteral(t *testing.T) {
+	testParseLiteral(t, scalars.Int64, []scalarTest{
+		{`null`, nil, false},
+		{`0`, int64(0), false},
+		{`-9223372036854775808`, int64(math.MinInt64), false},
+		{`9223372036854775807`, int64(math.MaxInt64), false},
+		{`"9223372036854775807"`, int64(math.MaxInt64), false},
+		{`9223372036854775808`, nil, true},
+		{`"12a"`, nil, true},
+		{`1.0`, nil, true},
+		{`true`, nil, true},
+		{`[1]`, nil, true},
+	})
+}
+
+func TestBigInt_Serialize(t *testing.T) {
+	huge, _ := new(big.Int).SetString("-123456789012345678901234567890", 10)
+	testScalarFn(t, "BigInt.Serialize", scalars.BigInt.Serialize, []scalarTest{
+		{nil, nil, false},
+		{(*big.Int)(nil), nil, false},
+		{huge, "-123456789012345678901234567890", false},
+		{*huge, "-123456789012345678901234567890", false},
+		{big.NewInt(0), "0", false},
+		{int8(-1), "-1", false},
+		{int64(math.MinInt64), "-9223372036854775808", false},
+		{uint64(math.MaxUint64), "18446744073709551615", false},
+		{float64(1e20), "100000000000000000000", false},
+		{float32(2.5), nil, true},
+		{math.Inf(-1), nil, true},
+		{"00012", "12", false},
+		{"-123456789012345678901234567890", "-123456789012345678901234567890", false},
+		{json.Number("1e3"), nil, true},
+		{"12.0", nil, true},
+		{"", nil, true},
+		{true, nil, true},
+	})
+}
+
+func TestBigInt_ParseValue(t *testing.T) {
+	testScalarFn(t, "BigInt.ParseValue", bigIntString(scalars.BigInt.ParseValue), []scalarTest{
+		{nil, nil, false},
+		{"123456789012345678901234567890", "123456789012345678901234567890", false},
+		{float64(-42), "-42", false},
+		{json.Number("18446744073709551616"), "18446744073709551616", false},
+		{float64(0.1), nil, true},
+		{"ten", nil, true},
+		{map[string]any{}, nil, true},
+	})
+}
+
+func TestBigInt_ParseLiteral(t *testing.T) {
+	parseLiteral := func(value any) (any, error) {
+		return scalars.BigInt.ParseLiteral(literal(t, value.(string)))
+	}
+	testScalarFn(t, "BigInt.ParseLiteral", bigIntString(parseLiteral), []scalarTest{
+		{`null`, nil, false},
+		{`-123456789012345678901234567890`, "-123456789012345678901234567890", false},
+		{`"123456789012345678901234567890"`, "123456789012345678901234567890", false},
+		{`1.5`, nil, true},
+		{`"1.5"`, nil, true},
+		{`ONE`, nil, true},
+	})
+}
+
+// bigIntString returns the parsed *big.Int values as strings to compare them.
+func bigIntString(parse func(any) (any, error)) func(any) (any, error) {
+	return func(value any) (any, error) {
+		parsed, err := parse(value)
+		if i, ok := parsed.(*big.Int); ok {
+			return i.String(), err
+		}
+		return parsed, err
+	}
+}
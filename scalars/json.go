@@ -0,0 +1,102 @@
+package scalars
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/language/ast"
+)
+
+// JSONConfig is the config of the JSON scalar.
+var JSONConfig = graphql.ScalarConfig{
+	Name: "JSON",
+	Description: "The `JSON` scalar type represents arbitrary JSON values: objects, " +
+		"arrays, strings, numbers, booleans and null.",
+	SpecifiedByURL: "https://www.rfc-editor.org/rfc/rfc8259",
+	Serialize:      serializeJSON,
+	ParseValue:     parseJSONValue,
+	ParseLiteral:   parseJSONLiteral,
+}
+
+// JSON represents arbitrary JSON values.
+//
+// Values are serialized as encoding/json encodes them, numbers become
+// json.Number values. Input values are used as they are decoded from the
+// variables. Literals are parsed into nil, bool, int64, float64, string,
+// []any and map[string]any values; variables nested in object and list
+// literals are replaced by their values.
+var JSON = graphql.NewScalar(JSONConfig)
+
+func serializeJSON(value any) (any, error) {
+	data, ok := value.(json.RawMessage)
+	if !ok {
+		var err error
+		if data, err = json.Marshal(value); err != nil {
+			return nil, fmt.Errorf("cannot serialize %T to JSON: %w", value, err)
+		}
+	}
+	return decodeJSON(data)
+}
+
+func parseJSONValue(value any) (any, error) {
+	if data, ok := value.(json.RawMessage); ok {
+		return decodeJSON(data)
+	}
+	return value, nil
+}
+
+func decodeJSON(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("cannot decode JSON: %w", err)
+	}
+	return value, nil
+}
+
+func parseJSONLiteral(valueAST ast.Value) (any, error) {
+	switch valueAST := valueAST.(type) {
+	case *ast.NullValue:
+		return nil, nil
+	case *ast.BooleanValue:
+		return valueAST.Value, nil
+	case *ast.IntValue:
+		if value, err := strconv.ParseInt(valueAST.Value, 10, 64); err == nil {
+			return value, nil
+		}
+		return strconv.ParseFloat(valueAST.Value, 64)
+	case *ast.FloatValue:
+		return strconv.ParseFloat(valueAST.Value, 64)
+	case *ast.StringValue:
+		return valueAST.Value, nil
+	case *ast.ListValue:
+		values := []any{}
+		for _, itemAST := range valueAST.Values {
+			value, err := parseJSONLiteral(itemAST)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	case *ast.ObjectValue:
+		values := map[string]any{}
+		for _, field := range valueAST.Fields {
+			value, err := parseJSONLiteral(field.Value)
+			if err != nil {
+				return nil, err
+			}
+			values[field.Name.Value] = value
+		}
+		return values, nil
+	case *ast.Variable:
+		// the executor replaces nested variables by their values, they are only
+		// seen when the literal is validated
+		return nil, nil
+	}
+	return nil, fmt.Errorf("cannot parse %T to JSON", valueAST)
+}
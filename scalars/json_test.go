@@ -0,0 +1,118 @@
+package scalars_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/scalars"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJSON_Serialize(t *testing.T) {
+	type user struct {
+		Name  string   `json:"name"`
+		Email *string  `json:"email,omitempty"`
+		Tags  []string `json:"tags"`
+	}
+	testScalarFn(t, "JSON.Serialize", scalars.JSON.Serialize, []scalarTest{
+		{nil, nil, false},
+		{true, true, false},
+		{"text", "text", false},
+		{1, json.Number("1"), false},
+		{int64(math.MaxInt64), json.Number("9223372036854775807"), false},
+		{1.5, json.Number("1.5"), false},
+		{[]int{1, 2}, []any{json.Number("1"), json.Number("2")}, false},
+		{map[string]any{"a": nil, "b": []any{"c"}}, map[string]any{"a": nil, "b": []any{"c"}}, false},
+		{user{Name: "ada"}, map[string]any{"name": "ada", "tags": nil}, false},
+		{&user{Name: "ada", Tags: []string{"admin"}}, map[string]any{"name": "ada", "tags": []any{"admin"}}, false},
+		{time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), "2024-01-02T03:04:05Z", false},
+		{json.RawMessage(`{"a":[1,true]}`), map[string]any{"a": []any{json.Number("1"), true}}, false},
+		{json.RawMessage(`{"a":`), nil, true},
+		{math.NaN(), nil, true},
+		{make(chan int), nil, true},
+		{func() {}, nil, true},
+	})
+}
+
+func TestJSON_ParseValue(t *testing.T) {
+	testScalarFn(t, "JSON.ParseValue", scalars.JSON.ParseValue, []scalarTest{
+		{nil, nil, false},
+		{false, false, false},
+		{float64(1), float64(1), false},
+		{"text", "text", false},
+		{[]any{"a", nil}, []any{"a", nil}, false},
+		{map[string]any{"a": map[string]any{}}, map[string]any{"a": map[string]any{}}, false},
+		{json.RawMessage(`[1]`), []any{json.Number("1")}, false},
+		{json.RawMessage(`[`), nil, true},
+	})
+}
+
+func TestJSON_ParseLiteral(t *testing.T) {
+	testParseLiteral(t, scalars.JSON, []scalarTest{
+		{`null`, nil, false},
+		{`true`, true, false},
+		{`1`, int64(1), false},
+		{`-9223372036854775808`, int64(math.MinInt64), false},
+		{`9223372036854775808`, float64(9223372036854775808), false},
+		{`1.5e3`, float64(1500), false},
+		{`"text"`, "text", false},
+		{`"""block"""`, "block", false},
+		{`[]`, []any{}, false},
+		{`[1, "a", [null]]`, []any{int64(1), "a", []any{nil}}, false},
+		{`{}`, map[string]any{}, false},
+		{`{a: {b: [true, 1.5]}, c: null}`, map[string]any{"a": map[string]any{"b": []any{true, 1.5}}, "c": nil}, false},
+		{`$var`, nil, false},
+		{`ENUM`, nil, true},
+		{`[ENUM]`, nil, true},
+		{`{a: ENUM}`, nil, true},
+	})
+}
+
+func TestJSON_ReplacesNestedVariables(t *testing.T) {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"echo": &graphql.Field{
+					Type: scalars.JSON,
+					Args: graphql.FieldConfigArgument{
+						&graphql.ArgumentConfig{Name: "value", Type: scalars.JSON},
+					},
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return p.Args["value"], nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema: schema,
+		RequestString: `query ($name: String, $tags: [String], $meta: JSON, $missing: Boolean) {
+			literal: echo(value: { name: $name, tags: $tags, meta: $meta, missing: $missing, list: [1, $name] })
+			variable: echo(value: $meta)
+		}`,
+		VariableValues: map[string]any{
+			"name": "ada",
+			"tags": []any{"admin", nil},
+			"meta": map[string]any{"level": float64(3), "nested": []any{true}},
+		},
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{
+		"literal": map[string]any{
+			"name":    "ada",
+			"tags":    []any{"admin", nil},
+			"meta":    map[string]any{"level": json.Number("3"), "nested": []any{true}},
+			"missing": nil,
+			"list":    []any{json.Number("1"), "ada"},
+		},
+		"variable": map[string]any{"level": json.Number("3"), "nested": []any{true}},
+	}, result.Data)
+}
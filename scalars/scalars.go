@@ -0,0 +1,47 @@
+// Package scalars provides custom scalar types commonly needed by schemas,
+// each with its serialization and coercion rules specified by the URL exposed
+// through @specifiedBy:
+//
+//   - JSON for arbitrary JSON values,
+//   - Int64 and BigInt for integers exceeding the range of Int,
+//   - Date, Time and Duration for calendar dates, times of day and durations,
+//   - UUID, URL and Email for the respective string formats.
+//
+// The scalars are used like the built-in ones:
+//
+//	"createdOn": &graphql.Field{
+//	  Type: scalars.Date,
+//	},
+//
+// or, for schemas built from SDL, through their configs in
+// BuildSchemaOptions.Scalars:
+//
+//	graphql.BuildSchema(sdl, graphql.BuildSchemaOptions{
+//	  Scalars: map[string]graphql.ScalarConfig{
+//	    "JSON": scalars.JSONConfig,
+//	  },
+//	})
+package scalars
+
+import (
+	"reflect"
+)
+
+// specificationURL is the base URL of the specification of the scalars without
+// a standard one.
+const specificationURL = "https://github.com/fraym/graphql-go/blob/master/scalars/README.md"
+
+// indirect dereferences pointers, returning nil for nil pointers.
+func indirect(value any) any {
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil
+	}
+	return rv.Interface()
+}
@@ -0,0 +1,68 @@
+package scalars_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/language/ast"
+	"github.com/fraym/graphql-go/language/parser"
+	"github.com/fraym/graphql-go/scalars"
+)
+
+type scalarTest struct {
+	Value    any
+	Expected any
+	Fails    bool
+}
+
+func testScalarFn(t *testing.T, name string, fn func(any) (any, error), tests []scalarTest) {
+	t.Helper()
+	for _, test := range tests {
+		value, err := fn(test.Value)
+		if test.Fails {
+			if err == nil {
+				t.Errorf("%v(%T(%v)) should have failed but got: %v", name, test.Value, test.Value, value)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%v(%T(%v)) failed: %v", name, test.Value, test.Value, err)
+			continue
+		}
+		if !reflect.DeepEqual(value, test.Expected) {
+			t.Errorf("%v(%T(%v)), expected: %T(%v), got: %T(%v)", name, test.Value, test.Value, test.Expected, test.Expected, value, value)
+		}
+	}
+}
+
+// testParseLiteral runs the tests with the literals given as GraphQL source.
+func testParseLiteral(t *testing.T, scalar *graphql.Scalar, tests []scalarTest) {
+	t.Helper()
+	testScalarFn(t, scalar.Name()+".ParseLiteral", func(value any) (any, error) {
+		return scalar.ParseLiteral(literal(t, value.(string)))
+	}, tests)
+}
+
+func literal(t *testing.T, source string) ast.Value {
+	t.Helper()
+	value, err := parser.ParseValue(parser.ParseParams{Source: source})
+	if err != nil {
+		t.Fatalf("failed to parse %v: %v", source, err)
+	}
+	return value
+}
+
+func TestScalars_SpecifiedByURL(t *testing.T) {
+	for _, scalar := range []*graphql.Scalar{
+		scalars.JSON, scalars.Int64, scalars.BigInt, scalars.Date, scalars.Time,
+		scalars.Duration, scalars.UUID, scalars.URL, scalars.Email,
+	} {
+		if scalar.Error() != nil {
+			t.Errorf("%v: %v", scalar.Name(), scalar.Error())
+		}
+		if scalar.SpecifiedByURL() == "" {
+			t.Errorf("%v has no specifiedBy URL", scalar.Name())
+		}
+	}
+}
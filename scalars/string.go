@@ -0,0 +1,161 @@
+package scalars
+
+import (
+	"encoding"
+	"encoding/hex"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"strings"
+
+	"github.com/fraym/graphql-go"
+)
+
+// UUIDConfig is the config of the UUID scalar.
+var UUIDConfig = graphql.ScalarConfig{
+	Name: "UUID",
+	Description: "The `UUID` scalar type represents a universally unique identifier, " +
+		"serialized in its lower case hexadecimal string form like " +
+		"\"f81d4fae-7dec-11d0-a765-00a0c91e6bf6\".",
+	SpecifiedByURL: "https://www.rfc-editor.org/rfc/rfc9562",
+	Serialize:      coerceUUID,
+	ParseValue:     coerceUUID,
+	ParseLiteral:   stringLiteral("UUID", coerceUUID),
+}
+
+// UUID represents UUIDs as strings in their lower case hexadecimal form.
+//
+// Strings, [16]byte arrays and types implementing encoding.TextMarshaler, like
+// the UUID types of common UUID packages, are serialized. Input values must be
+// strings in hexadecimal form, upper case digits are accepted.
+var UUID = graphql.NewScalar(UUIDConfig)
+
+// URLConfig is the config of the URL scalar.
+var URLConfig = graphql.ScalarConfig{
+	Name: "URL",
+	Description: "The `URL` scalar type represents an absolute URL like " +
+		"\"https://example.com/path\".",
+	SpecifiedByURL: "https://www.rfc-editor.org/rfc/rfc3986",
+	Serialize:      serializeURL,
+	ParseValue:     parseURLValue,
+	ParseLiteral:   stringLiteral("URL", parseURLValue),
+}
+
+// URL represents absolute URLs as *url.URL values.
+//
+// url.URL values and strings are serialized if they are absolute URLs.
+var URL = graphql.NewScalar(URLConfig)
+
+// EmailConfig is the config of the Email scalar.
+var EmailConfig = graphql.ScalarConfig{
+	Name: "Email",
+	Description: "The `Email` scalar type represents an email address like " +
+		"\"gopher@example.com\", without display name.",
+	SpecifiedByURL: "https://www.rfc-editor.org/rfc/rfc5322#section-3.4.1",
+	Serialize:      coerceEmail,
+	ParseValue:     coerceEmail,
+	ParseLiteral:   stringLiteral("Email", coerceEmail),
+}
+
+// Email represents email addresses as strings.
+//
+// Strings and mail.Address values are serialized, the display name of the
+// latter is left out.
+var Email = graphql.NewScalar(EmailConfig)
+
+func coerceUUID(value any) (any, error) {
+	switch v := indirect(value).(type) {
+	case string:
+		return normalizeUUID(v)
+	case nil:
+		return nil, nil
+	case encoding.TextMarshaler:
+		text, err := v.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+		return normalizeUUID(string(text))
+	}
+	rv := reflect.ValueOf(indirect(value))
+	if rv.Kind() == reflect.Array && rv.Len() == 16 && rv.Type().Elem().Kind() == reflect.Uint8 {
+		var b [16]byte
+		reflect.Copy(reflect.ValueOf(b[:]), rv)
+		return formatUUID(b), nil
+	}
+	return nil, fmt.Errorf("cannot coerce %T to UUID", value)
+}
+
+func normalizeUUID(value string) (any, error) {
+	var b [16]byte
+	groups := strings.Split(value, "-")
+	if len(value) != 36 || len(groups) != 5 {
+		return nil, fmt.Errorf("value %q is not a UUID", value)
+	}
+	decoded := 0
+	for i, group := range groups {
+		if len(group) != []int{8, 4, 4, 4, 12}[i] {
+			return nil, fmt.Errorf("value %q is not a UUID", value)
+		}
+		n, err := hex.Decode(b[decoded:], []byte(group))
+		if err != nil {
+			return nil, fmt.Errorf("value %q is not a UUID", value)
+		}
+		decoded += n
+	}
+	return formatUUID(b), nil
+}
+
+func formatUUID(b [16]byte) string {
+	s := hex.EncodeToString(b[:])
+	return s[:8] + "-" + s[8:12] + "-" + s[12:16] + "-" + s[16:20] + "-" + s[20:]
+}
+
+func serializeURL(value any) (any, error) {
+	switch value := indirect(value).(type) {
+	case url.URL:
+		if !value.IsAbs() {
+			return nil, fmt.Errorf("value %q is not an absolute URL", value.String())
+		}
+		return value.String(), nil
+	case string:
+		u, err := parseURLValue(value)
+		if err != nil {
+			return nil, err
+		}
+		return u.(*url.URL).String(), nil
+	case nil:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("cannot serialize %T to URL", value)
+}
+
+func parseURLValue(value any) (any, error) {
+	switch value := indirect(value).(type) {
+	case string:
+		u, err := url.Parse(value)
+		if err != nil || !u.IsAbs() {
+			return nil, fmt.Errorf("value %q is not an absolute URL", value)
+		}
+		return u, nil
+	case nil:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("cannot parse %T to URL", value)
+}
+
+func coerceEmail(value any) (any, error) {
+	switch value := indirect(value).(type) {
+	case string:
+		address, err := mail.ParseAddress(value)
+		if err != nil || address.Name != "" || address.Address != value {
+			return nil, fmt.Errorf("value %q is not an email address", value)
+		}
+		return value, nil
+	case mail.Address:
+		return coerceEmail(value.Address)
+	case nil:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("cannot coerce %T to Email", value)
+}
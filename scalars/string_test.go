@@ -0,0 +1,138 @@
+package scalars_test
+
+import (
+	"net/mail"
+	"net/url"
+	"testing"
+
+	"github.com/fraym/graphql-go/scalars"
+)
+
+type testUUID [16]byte
+
+type textUUID struct {
+	text string
+}
+
+func (u textUUID) MarshalText() ([]byte, error) {
+	return []byte(u.text), nil
+}
+
+func TestUUID_Serialize(t *testing.T) {
+	id := "f81d4fae-7dec-11d0-a765-00a0c91e6bf6"
+	testScalarFn(t, "UUID.Serialize", scalars.UUID.Serialize, []scalarTest{
+		{nil, nil, false},
+		{(*string)(nil), nil, false},
+		{id, id, false},
+		{&id, id, false},
+		{"F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6", id, false},
+		{[16]byte{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}, id, false},
+		{testUUID{0xf8, 0x1d, 0x4f, 0xae, 0x7d, 0xec, 0x11, 0xd0, 0xa7, 0x65, 0x00, 0xa0, 0xc9, 0x1e, 0x6b, 0xf6}, id, false},
+		{textUUID{id}, id, false},
+		{textUUID{"invalid"}, nil, true},
+		{[15]byte{}, nil, true},
+		{[]byte(id), nil, true},
+		{42, nil, true},
+	})
+}
+
+func TestUUID_ParseValue(t *testing.T) {
+	testScalarFn(t, "UUID.ParseValue", scalars.UUID.ParseValue, []scalarTest{
+		{nil, nil, false},
+		{"00000000-0000-0000-0000-000000000000", "00000000-0000-0000-0000-000000000000", false},
+		{"F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6", "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", false},
+		{"f81d4fae7dec11d0a76500a0c91e6bf6", nil, true},
+		{"{f81d4fae-7dec-11d0-a765-00a0c91e6bf6}", nil, true},
+		{"urn:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6", nil, true},
+		{"f81d4fae-7dec-11d0-a765-00a0c91e6bf", nil, true},
+		{"f81d4fae-7dec-11d0a-765-00a0c91e6bf6", nil, true},
+		{"g81d4fae-7dec-11d0-a765-00a0c91e6bf6", nil, true},
+		{"", nil, true},
+		{float64(1), nil, true},
+	})
+}
+
+func TestUUID_ParseLiteral(t *testing.T) {
+	testParseLiteral(t, scalars.UUID, []scalarTest{
+		{`null`, nil, false},
+		{`"F81D4FAE-7DEC-11D0-A765-00A0C91E6BF6"`, "f81d4fae-7dec-11d0-a765-00a0c91e6bf6", false},
+		{`"f81d4fae"`, nil, true},
+		{`1`, nil, true},
+	})
+}
+
+func TestURL_Serialize(t *testing.T) {
+	u, _ := url.Parse("https://example.com/path?q=1#top")
+	testScalarFn(t, "URL.Serialize", scalars.URL.Serialize, []scalarTest{
+		{nil, nil, false},
+		{(*url.URL)(nil), nil, false},
+		{u, "https://example.com/path?q=1#top", false},
+		{*u, "https://example.com/path?q=1#top", false},
+		{url.URL{Path: "/relative"}, nil, true},
+		{"mailto:gopher@example.com", "mailto:gopher@example.com", false},
+		{"HTTPS://example.com/a b", "https://example.com/a%20b", false},
+		{"/relative", nil, true},
+		{"example.com", nil, true},
+		{"https://exa mple.com", nil, true},
+		{1, nil, true},
+	})
+}
+
+func TestURL_ParseValue(t *testing.T) {
+	u, _ := url.Parse("https://example.com/path")
+	testScalarFn(t, "URL.ParseValue", scalars.URL.ParseValue, []scalarTest{
+		{nil, nil, false},
+		{"https://example.com/path", u, false},
+		{"//example.com/path", nil, true},
+		{"", nil, true},
+		{"http://[::1", nil, true},
+		{true, nil, true},
+	})
+}
+
+func TestURL_ParseLiteral(t *testing.T) {
+	u, _ := url.Parse("ftp://example.com/file")
+	testParseLiteral(t, scalars.URL, []scalarTest{
+		{`null`, nil, false},
+		{`"ftp://example.com/file"`, u, false},
+		{`"file"`, nil, true},
+		{`[]`, nil, true},
+	})
+}
+
+func TestEmail_Serialize(t *testing.T) {
+	testScalarFn(t, "Email.Serialize", scalars.Email.Serialize, []scalarTest{
+		{nil, nil, false},
+		{"gopher@example.com", "gopher@example.com", false},
+		{mail.Address{Name: "Gopher", Address: "gopher@example.com"}, "gopher@example.com", false},
+		{&mail.Address{Address: "gopher@example.com"}, "gopher@example.com", false},
+		{"Gopher <gopher@example.com>", nil, true},
+		{"gopher", nil, true},
+		{1, nil, true},
+	})
+}
+
+func TestEmail_ParseValue(t *testing.T) {
+	testScalarFn(t, "Email.ParseValue", scalars.Email.ParseValue, []scalarTest{
+		{nil, nil, false},
+		{"gopher@example.com", "gopher@example.com", false},
+		{"first.last+tag@sub.example.co", "first.last+tag@sub.example.co", false},
+		{"Gopher <gopher@example.com>", nil, true},
+		{"<gopher@example.com>", nil, true},
+		{" gopher@example.com", nil, true},
+		{"gopher@", nil, true},
+		{"@example.com", nil, true},
+		{"go pher@example.com", nil, true},
+		{"", nil, true},
+		{[]string{"gopher@example.com"}, nil, true},
+	})
+}
+
+func TestEmail_ParseLiteral(t *testing.T) {
+	testParseLiteral(t, scalars.Email, []scalarTest{
+		{`null`, nil, false},
+		{`"gopher@example.com"`, "gopher@example.com", false},
+		{`"gopher"`, nil, true},
+		{`GOPHER`, nil, true},
+	})
+}
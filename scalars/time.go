@@ -0,0 +1,273 @@
+package scalars
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/language/ast"
+)
+
+const (
+	dateLayout = "2006-01-02"
+	timeLayout = "15:04:05.999999999"
+)
+
+// DateConfig is the config of the Date scalar.
+var DateConfig = graphql.ScalarConfig{
+	Name: "Date",
+	Description: "The `Date` scalar type represents a calendar date without time and " +
+		"time zone, serialized as an RFC 3339 full-date string like \"2006-01-02\".",
+	SpecifiedByURL: "https://www.rfc-editor.org/rfc/rfc3339#section-5.6",
+	Serialize:      serializeDate,
+	ParseValue:     parseDateValue,
+	ParseLiteral:   stringLiteral("Date", parseDateValue),
+}
+
+// Date represents calendar dates as time.Time values at midnight UTC.
+//
+// time.Time values are serialized with their date in their own location,
+// strings are serialized if they are valid dates.
+var Date = graphql.NewScalar(DateConfig)
+
+// TimeConfig is the config of the Time scalar.
+var TimeConfig = graphql.ScalarConfig{
+	Name: "Time",
+	Description: "The `Time` scalar type represents a time of day without date and " +
+		"time zone, serialized as an RFC 3339 partial-time string like \"15:04:05\" " +
+		"or \"15:04:05.123\".",
+	SpecifiedByURL: "https://www.rfc-editor.org/rfc/rfc3339#section-5.6",
+	Serialize:      serializeTime,
+	ParseValue:     parseTimeValue,
+	ParseLiteral:   stringLiteral("Time", parseTimeValue),
+}
+
+// Time represents times of day as time.Time values on January 1, year 0 UTC.
+//
+// time.Time values are serialized with their clock in their own location,
+// strings are serialized if they are valid times of day.
+var Time = graphql.NewScalar(TimeConfig)
+
+// DurationConfig is the config of the Duration scalar.
+var DurationConfig = graphql.ScalarConfig{
+	Name: "Duration",
+	Description: "The `Duration` scalar type represents an amount of time, serialized as " +
+		"an ISO 8601 duration string like \"PT1H30M\" or \"-PT0.5S\".",
+	SpecifiedByURL: specificationURL + "#duration",
+	Serialize:      serializeDuration,
+	ParseValue:     parseDurationValue,
+	ParseLiteral:   stringLiteral("Duration", parseDurationValue),
+}
+
+// Duration represents durations as time.Duration values.
+//
+// Durations are serialized in hours, minutes and seconds. Input values may
+// also use weeks and days, which are 7 and 1 times 24 hours; years and months
+// are rejected as their length varies.
+var Duration = graphql.NewScalar(DurationConfig)
+
+// stringLiteral returns a ParseLiteralFn that parses string literals with
+// parse.
+func stringLiteral(name string, parse graphql.ParseValueFn) graphql.ParseLiteralFn {
+	return func(valueAST ast.Value) (any, error) {
+		switch valueAST := valueAST.(type) {
+		case *ast.StringValue:
+			return parse(valueAST.Value)
+		case *ast.NullValue:
+			return nil, nil
+		}
+		return nil, fmt.Errorf("cannot parse %T to %v", valueAST, name)
+	}
+}
+
+func serializeDate(value any) (any, error) {
+	switch value := indirect(value).(type) {
+	case time.Time:
+		return value.Format(dateLayout), nil
+	case string:
+		if _, err := parseDateValue(value); err != nil {
+			return nil, err
+		}
+		return value, nil
+	case nil:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("cannot serialize %T to Date", value)
+}
+
+func parseDateValue(value any) (any, error) {
+	switch value := indirect(value).(type) {
+	case string:
+		date, err := time.Parse(dateLayout, value)
+		if err != nil {
+			return nil, fmt.Errorf("value %q is not a date", value)
+		}
+		return date, nil
+	case nil:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("cannot parse %T to Date", value)
+}
+
+func serializeTime(value any) (any, error) {
+	switch value := indirect(value).(type) {
+	case time.Time:
+		return value.Format(timeLayout), nil
+	case string:
+		if _, err := parseTimeValue(value); err != nil {
+			return nil, err
+		}
+		return value, nil
+	case nil:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("cannot serialize %T to Time", value)
+}
+
+func parseTimeValue(value any) (any, error) {
+	switch value := indirect(value).(type) {
+	case string:
+		// the fractional seconds are optional when parsing, the hour layout
+		// accepts a single digit which RFC 3339 doesn't
+		t, err := time.Parse("15:04:05", value)
+		if err != nil || len(value) < 3 || value[2] != ':' {
+			return nil, fmt.Errorf("value %q is not a time", value)
+		}
+		return t, nil
+	case nil:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("cannot parse %T to Time", value)
+}
+
+func serializeDuration(value any) (any, error) {
+	switch value := indirect(value).(type) {
+	case time.Duration:
+		return formatDuration(value), nil
+	case string:
+		if _, err := parseDurationValue(value); err != nil {
+			return nil, err
+		}
+		return value, nil
+	case nil:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("cannot serialize %T to Duration", value)
+}
+
+func parseDurationValue(value any) (any, error) {
+	switch value := indirect(value).(type) {
+	case string:
+		d, ok := parseDuration(value)
+		if !ok {
+			return nil, fmt.Errorf("value %q is not a duration", value)
+		}
+		return d, nil
+	case nil:
+		return nil, nil
+	}
+	return nil, fmt.Errorf("cannot parse %T to Duration", value)
+}
+
+// formatDuration formats the duration like "-PT1H2M3.5S".
+func formatDuration(d time.Duration) string {
+	if d == 0 {
+		return "PT0S"
+	}
+	b := strings.Builder{}
+	n := uint64(d)
+	if d < 0 {
+		b.WriteString("-")
+		n = -n
+	}
+	b.WriteString("PT")
+	if hours := n / uint64(time.Hour); hours > 0 {
+		b.WriteString(strconv.FormatUint(hours, 10) + "H")
+	}
+	if minutes := n % uint64(time.Hour) / uint64(time.Minute); minutes > 0 {
+		b.WriteString(strconv.FormatUint(minutes, 10) + "M")
+	}
+	seconds, nanos := n%uint64(time.Minute)/uint64(time.Second), n%uint64(time.Second)
+	if seconds > 0 || nanos > 0 {
+		b.WriteString(strconv.FormatUint(seconds, 10))
+		if nanos > 0 {
+			b.WriteString("." + strings.TrimRight(fmt.Sprintf("%09d", nanos), "0"))
+		}
+		b.WriteString("S")
+	}
+	return b.String()
+}
+
+// parseDuration parses ISO 8601 durations like "P1DT12H" with weeks, days,
+// hours, minutes and seconds. Only seconds may have a fraction.
+func parseDuration(value string) (time.Duration, bool) {
+	s, negative := strings.CutPrefix(value, "-")
+	s, ok := strings.CutPrefix(s, "P")
+	if !ok || s == "" {
+		return 0, false
+	}
+	datePart, timePart, hasTime := strings.Cut(s, "T")
+	if hasTime && timePart == "" {
+		return 0, false
+	}
+
+	var total uint64
+	for _, part := range []struct {
+		value       string
+		designators string
+		units       []time.Duration
+	}{
+		{datePart, "WD", []time.Duration{7 * 24 * time.Hour, 24 * time.Hour}},
+		{timePart, "HMS", []time.Duration{time.Hour, time.Minute, time.Second}},
+	} {
+		s, designators, units := part.value, part.designators, part.units
+		for s != "" {
+			end := strings.IndexFunc(s, func(r rune) bool {
+				return (r < '0' || r > '9') && r != '.'
+			})
+			if end <= 0 {
+				return 0, false
+			}
+			index := strings.IndexByte(designators, s[end])
+			if index < 0 {
+				return 0, false
+			}
+			n, ok := durationComponent(s[:end], units[index])
+			if !ok || n > math.MaxInt64-total {
+				return 0, false
+			}
+			total += n
+			s, designators, units = s[end+1:], designators[index+1:], units[index+1:]
+		}
+	}
+
+	if negative {
+		return -time.Duration(total), true
+	}
+	return time.Duration(total), true
+}
+
+// durationComponent returns the number of nanoseconds of a component of a
+// duration, e.g. "1.5" seconds.
+func durationComponent(number string, unit time.Duration) (uint64, bool) {
+	whole, fraction, hasFraction := strings.Cut(number, ".")
+	if whole == "" || hasFraction && (unit != time.Second || fraction == "" || len(fraction) > 9) {
+		return 0, false
+	}
+	n, err := strconv.ParseUint(whole, 10, 64)
+	if err != nil || n > math.MaxInt64/uint64(unit) {
+		return 0, false
+	}
+	n *= uint64(unit)
+	if hasFraction {
+		nanos, err := strconv.ParseUint(fraction+strings.Repeat("0", 9-len(fraction)), 10, 64)
+		if err != nil {
+			return 0, false
+		}
+		n += nanos
+	}
+	return n, true
+}
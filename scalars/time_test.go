@@ -0,0 +1,145 @@
+package scalars_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/fraym/graphql-go/scalars"
+)
+
+func TestDate_Serialize(t *testing.T) {
+	date := time.Date(2024, 2, 29, 23, 30, 0, 0, time.FixedZone("", -3600))
+	testScalarFn(t, "Date.Serialize", scalars.Date.Serialize, []scalarTest{
+		{nil, nil, false},
+		{(*time.Time)(nil), nil, false},
+		{date, "2024-02-29", false},
+		{&date, "2024-02-29", false},
+		{"2024-02-29", "2024-02-29", false},
+		{"2023-02-29", nil, true},
+		{"2024-02-29T00:00:00Z", nil, true},
+		{20240229, nil, true},
+	})
+}
+
+func TestDate_ParseValue(t *testing.T) {
+	testScalarFn(t, "Date.ParseValue", scalars.Date.ParseValue, []scalarTest{
+		{nil, nil, false},
+		{"2024-02-29", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), false},
+		{"0001-01-01", time.Time{}, false},
+		{"2024-2-29", nil, true},
+		{"2024-13-01", nil, true},
+		{"29.02.2024", nil, true},
+		{"", nil, true},
+		{time.Now(), nil, true},
+	})
+}
+
+func TestDate_ParseLiteral(t *testing.T) {
+	testParseLiteral(t, scalars.Date, []scalarTest{
+		{`null`, nil, false},
+		{`"2024-02-29"`, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), false},
+		{`"2024-02-30"`, nil, true},
+		{`20240229`, nil, true},
+	})
+}
+
+func TestTime_Serialize(t *testing.T) {
+	testScalarFn(t, "Time.Serialize", scalars.Time.Serialize, []scalarTest{
+		{nil, nil, false},
+		{time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), "03:04:05", false},
+		{time.Date(2024, 1, 2, 15, 4, 5, 120000000, time.UTC), "15:04:05.12", false},
+		{time.Date(2024, 1, 2, 23, 59, 59, 999999999, time.UTC), "23:59:59.999999999", false},
+		{"12:00:00", "12:00:00", false},
+		{"12:00", nil, true},
+		{"24:00:00", nil, true},
+		{time.Hour, nil, true},
+	})
+}
+
+func TestTime_ParseValue(t *testing.T) {
+	testScalarFn(t, "Time.ParseValue", scalars.Time.ParseValue, []scalarTest{
+		{nil, nil, false},
+		{"03:04:05", time.Date(0, 1, 1, 3, 4, 5, 0, time.UTC), false},
+		{"15:04:05.123", time.Date(0, 1, 1, 15, 4, 5, 123000000, time.UTC), false},
+		{"15:04:05.123456789", time.Date(0, 1, 1, 15, 4, 5, 123456789, time.UTC), false},
+		{"3:04:05", nil, true},
+		{"15:04", nil, true},
+		{"15:04:60", nil, true},
+		{"15:04:05Z", nil, true},
+		{"15:04:05+01:00", nil, true},
+		{float64(0), nil, true},
+	})
+}
+
+func TestTime_ParseLiteral(t *testing.T) {
+	testParseLiteral(t, scalars.Time, []scalarTest{
+		{`null`, nil, false},
+		{`"00:00:00"`, time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{`"noon"`, nil, true},
+		{`1200`, nil, true},
+	})
+}
+
+func TestDuration_Serialize(t *testing.T) {
+	d := 90 * time.Minute
+	testScalarFn(t, "Duration.Serialize", scalars.Duration.Serialize, []scalarTest{
+		{nil, nil, false},
+		{(*time.Duration)(nil), nil, false},
+		{time.Duration(0), "PT0S", false},
+		{d, "PT1H30M", false},
+		{&d, "PT1H30M", false},
+		{time.Nanosecond, "PT0.000000001S", false},
+		{250 * time.Millisecond, "PT0.25S", false},
+		{-2 * time.Hour, "-PT2H", false},
+		{49*time.Hour + 5*time.Second, "PT49H5S", false},
+		{time.Duration(math.MaxInt64), "PT2562047H47M16.854775807S", false},
+		{time.Duration(math.MinInt64), "-PT2562047H47M16.854775808S", false},
+		{"P1D", "P1D", false},
+		{"P1Y", nil, true},
+		{int64(1), nil, true},
+	})
+}
+
+func TestDuration_ParseValue(t *testing.T) {
+	testScalarFn(t, "Duration.ParseValue", scalars.Duration.ParseValue, []scalarTest{
+		{nil, nil, false},
+		{"PT0S", time.Duration(0), false},
+		{"PT1H30M", 90 * time.Minute, false},
+		{"PT90M", 90 * time.Minute, false},
+		{"PT1.5S", 1500 * time.Millisecond, false},
+		{"PT0.000000001S", time.Nanosecond, false},
+		{"-PT2H", -2 * time.Hour, false},
+		{"P1D", 24 * time.Hour, false},
+		{"P2W", 14 * 24 * time.Hour, false},
+		{"P1W2DT3H4M5S", (7+2)*24*time.Hour + 3*time.Hour + 4*time.Minute + 5*time.Second, false},
+		{"PT2562047H47M16.854775807S", time.Duration(math.MaxInt64), false},
+		{"PT2562047H47M16.854775808S", nil, true},
+		{"PT9223372036854775807S", nil, true},
+		{"P", nil, true},
+		{"PT", nil, true},
+		{"P1DT", nil, true},
+		{"PT1S1M", nil, true},
+		{"PT1H1H", nil, true},
+		{"P1Y", nil, true},
+		{"P1M", nil, true},
+		{"PT1.5M", nil, true},
+		{"PT.5S", nil, true},
+		{"PT1.S", nil, true},
+		{"PT0.0000000001S", nil, true},
+		{"PTS", nil, true},
+		{"PT-1S", nil, true},
+		{"1h30m", nil, true},
+		{"pt1h", nil, true},
+		{float64(60), nil, true},
+	})
+}
+
+func TestDuration_ParseLiteral(t *testing.T) {
+	testParseLiteral(t, scalars.Duration, []scalarTest{
+		{`null`, nil, false},
+		{`"PT1M"`, time.Minute, false},
+		{`"PT1X"`, nil, true},
+		{`60`, nil, true},
+	})
+}
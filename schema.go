@@ -1,5 +1,10 @@
 package graphql
 
+import (
+	"sort"
+	"strings"
+)
+
 type SchemaConfig struct {
 	Query        *Object
 	Mutation     *Object
@@ -41,8 +46,11 @@ type Schema struct {
 	mutationType     *Object
 	subscriptionType *Object
 	implementations  map[string][]*Object
-	possibleTypeMap  map[string]map[string]bool
-	extensions       []Extension
+	// possibleTypeMap holds the names of the possible types by the names of
+	// the abstract types. It is computed once, so concurrent executions can
+	// share the schema.
+	possibleTypeMap map[string]map[string]bool
+	extensions      []Extension
 }
 
 func NewSchema(config SchemaConfig) (Schema, error) {
@@ -126,15 +134,14 @@ func NewSchema(config SchemaConfig) (Schema, error) {
 	}
 
 	// Enforce correct interface implementations
-	for _, ttype := range schema.typeMap {
-		if ttype, ok := ttype.(*Object); ok {
-			for _, iface := range ttype.Interfaces() {
-				err := assertObjectImplementsInterface(&schema, ttype, iface)
-				if err != nil {
-					return schema, err
-				}
-			}
-		}
+	if err := assertValidImplementations(&schema); err != nil {
+		return schema, err
+	}
+	schema.buildPossibleTypeMap()
+
+	// Enforce applied directives to be defined and used at allowed locations
+	if err := assertValidAppliedDirectives(&schema); err != nil {
+		return schema, err
 	}
 
 	// Add extensions from config
@@ -166,18 +173,27 @@ func (gq *Schema) AddImplementation() error {
 	}
 
 	// Enforce correct interface implementations
+	if err := assertValidImplementations(gq); err != nil {
+		return err
+	}
+	gq.buildPossibleTypeMap()
+	return nil
+}
+
+// buildPossibleTypeMap computes the possible types of the abstract types of
+// the type map.
+func (gq *Schema) buildPossibleTypeMap() {
+	gq.possibleTypeMap = map[string]map[string]bool{}
 	for _, ttype := range gq.typeMap {
-		if ttype, ok := ttype.(*Object); ok {
-			for _, iface := range ttype.Interfaces() {
-				err := assertObjectImplementsInterface(gq, ttype, iface)
-				if err != nil {
-					return err
-				}
+		switch ttype.(type) {
+		case *Union, *Interface:
+			typeMap := map[string]bool{}
+			for _, possibleType := range gq.PossibleTypes(ttype) {
+				typeMap[possibleType.Name()] = true
 			}
+			gq.possibleTypeMap[ttype.Name()] = typeMap
 		}
 	}
-
-	return nil
 }
 
 // Edited. To check add Types at RunTime..
@@ -241,23 +257,14 @@ func (gq *Schema) PossibleTypes(abstractType Abstract) []*Object {
 }
 
 func (gq *Schema) IsPossibleType(abstractType Abstract, possibleType *Object) bool {
-	possibleTypeMap := gq.possibleTypeMap
-	if possibleTypeMap == nil {
-		possibleTypeMap = map[string]map[string]bool{}
+	if typeMap, ok := gq.possibleTypeMap[abstractType.Name()]; ok {
+		return typeMap[possibleType.Name()]
 	}
-
-	if _, ok := possibleTypeMap[abstractType.Name()]; !ok {
-		typeMap := map[string]bool{}
-		for _, possibleType := range gq.PossibleTypes(abstractType) {
-			typeMap[possibleType.Name()] = true
+	// abstract types which are not part of the schema
+	for _, t := range gq.PossibleTypes(abstractType) {
+		if t.Name() == possibleType.Name() {
+			return true
 		}
-		possibleTypeMap[abstractType.Name()] = typeMap
-	}
-
-	gq.possibleTypeMap = possibleTypeMap
-	if typeMap, ok := possibleTypeMap[abstractType.Name()]; ok {
-		isPossible := typeMap[possibleType.Name()]
-		return isPossible
 	}
 	return false
 }
@@ -313,6 +320,20 @@ func typeMapReducer(schema *Schema, typeMap TypeMap, objectType Type) (TypeMap,
 				return typeMap, err
 			}
 		}
+		if objectType, ok := objectType.(*Interface); ok {
+			interfaces := objectType.Interfaces()
+			if objectType.err != nil {
+				return typeMap, objectType.err
+			}
+			for _, innerObjectType := range interfaces {
+				if innerObjectType.err != nil {
+					return typeMap, innerObjectType.err
+				}
+				if typeMap, err = typeMapReducer(schema, typeMap, innerObjectType); err != nil {
+					return typeMap, err
+				}
+			}
+		}
 	case *Object:
 		interfaces := objectType.Interfaces()
 		if objectType.err != nil {
@@ -378,7 +399,49 @@ func typeMapReducer(schema *Schema, typeMap TypeMap, objectType Type) (TypeMap,
 	return typeMap, nil
 }
 
-func assertObjectImplementsInterface(schema *Schema, object *Object, iface *Interface) error {
+// implementingType is a type which may implement interfaces: an Object or an Interface.
+type implementingType interface {
+	Type
+	Fields() FieldDefinitionMap
+	Interfaces() []*Interface
+}
+
+// assertValidImplementations asserts every object and interface of the schema
+// correctly implements its interfaces.
+func assertValidImplementations(schema *Schema) error {
+	for _, ttype := range schema.typeMap {
+		implementer, ok := ttype.(implementingType)
+		if !ok {
+			continue
+		}
+		for _, iface := range implementer.Interfaces() {
+			if err := assertImplementsInterface(schema, implementer, iface); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func assertImplementsInterface(schema *Schema, object implementingType, iface *Interface) error {
+	// Assert the interfaces implemented by the interface are also implemented.
+	for _, ancestor := range iface.Interfaces() {
+		implemented := false
+		for _, other := range object.Interfaces() {
+			if other.Name() == ancestor.Name() {
+				implemented = true
+				break
+			}
+		}
+		err := invariantf(
+			implemented,
+			`Type %v must implement %v because it is implemented by %v.`, object, ancestor, iface,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
 	objectFieldMap := object.Fields()
 	ifaceFieldMap := iface.Fields()
 
@@ -532,6 +595,13 @@ func isTypeSubTypeOf(schema *Schema, maybeSubType Type, superType Type) bool {
 		if maybeSubType, ok := maybeSubType.(*Object); ok && schema.IsPossibleType(superType, maybeSubType) {
 			return true
 		}
+		if maybeSubType, ok := maybeSubType.(*Interface); ok {
+			for _, iface := range maybeSubType.Interfaces() {
+				if iface.Name() == superType.Name() {
+					return true
+				}
+			}
+		}
 	}
 	if superType, ok := superType.(*Union); ok {
 		if maybeSubType, ok := maybeSubType.(*Object); ok && schema.IsPossibleType(superType, maybeSubType) {
@@ -542,3 +612,180 @@ func isTypeSubTypeOf(schema *Schema, maybeSubType Type, superType Type) bool {
 	// Otherwise, the child type is not a valid subtype of the parent type.
 	return false
 }
+
+// assertValidAppliedDirectives asserts every directive applied to a type, field,
+// argument, enum value or input field is defined by the schema, allowed at that
+// location and given valid arguments.
+func assertValidAppliedDirectives(schema *Schema) error {
+	for _, directive := range schema.Directives() {
+		for _, arg := range directive.Args {
+			coordinate := "@" + directive.Name + "(" + arg.Name() + ":)"
+			if err := assertValidAppliedDirectivesAt(
+				schema, arg.AppliedDirectives, DirectiveLocationArgumentDefinition, coordinate,
+			); err != nil {
+				return err
+			}
+		}
+	}
+
+	typeNames := make([]string, 0, len(schema.typeMap))
+	for typeName := range schema.typeMap {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+	for _, typeName := range typeNames {
+		var err error
+		switch ttype := schema.typeMap[typeName].(type) {
+		case *Scalar:
+			err = assertValidAppliedDirectivesAt(schema, ttype.AppliedDirectives(), DirectiveLocationScalar, typeName)
+		case *Object:
+			if err = assertValidAppliedDirectivesAt(schema, ttype.AppliedDirectives(), DirectiveLocationObject, typeName); err == nil {
+				err = assertValidFieldAppliedDirectives(schema, typeName, ttype.Fields())
+			}
+		case *Interface:
+			if err = assertValidAppliedDirectivesAt(schema, ttype.AppliedDirectives(), DirectiveLocationInterface, typeName); err == nil {
+				err = assertValidFieldAppliedDirectives(schema, typeName, ttype.Fields())
+			}
+		case *Union:
+			err = assertValidAppliedDirectivesAt(schema, ttype.AppliedDirectives(), DirectiveLocationUnion, typeName)
+		case *Enum:
+			if err = assertValidAppliedDirectivesAt(schema, ttype.AppliedDirectives(), DirectiveLocationEnum, typeName); err != nil {
+				break
+			}
+			for _, value := range ttype.Values() {
+				if err = assertValidAppliedDirectivesAt(
+					schema, value.AppliedDirectives, DirectiveLocationEnumValue, typeName+"."+value.Name,
+				); err != nil {
+					break
+				}
+			}
+		case *InputObject:
+			if err = assertValidAppliedDirectivesAt(schema, ttype.AppliedDirectives(), DirectiveLocationInputObject, typeName); err != nil {
+				break
+			}
+			fields := ttype.Fields()
+			fieldNames := make([]string, 0, len(fields))
+			for fieldName := range fields {
+				fieldNames = append(fieldNames, fieldName)
+			}
+			sort.Strings(fieldNames)
+			for _, fieldName := range fieldNames {
+				if err = assertValidAppliedDirectivesAt(
+					schema, fields[fieldName].AppliedDirectives, DirectiveLocationInputFieldDefinition, typeName+"."+fieldName,
+				); err != nil {
+					break
+				}
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func assertValidFieldAppliedDirectives(schema *Schema, typeName string, fields FieldDefinitionMap) error {
+	fieldNames := make([]string, 0, len(fields))
+	for fieldName := range fields {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+	for _, fieldName := range fieldNames {
+		field := fields[fieldName]
+		coordinate := typeName + "." + fieldName
+		if err := assertValidAppliedDirectivesAt(
+			schema, field.AppliedDirectives, DirectiveLocationFieldDefinition, coordinate,
+		); err != nil {
+			return err
+		}
+		for _, arg := range field.Args {
+			if err := assertValidAppliedDirectivesAt(
+				schema, arg.AppliedDirectives, DirectiveLocationArgumentDefinition, coordinate+"("+arg.Name()+":)",
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// assertValidAppliedDirectivesAt asserts the directives applied to the schema
+// element identified by coordinate, which is found at the given location.
+func assertValidAppliedDirectivesAt(schema *Schema, applied []*AppliedDirective, location string, coordinate string) error {
+	seen := map[string]bool{}
+	for _, appliedDirective := range applied {
+		if err := invariantf(
+			appliedDirective != nil,
+			`%v must not have a nil applied directive.`, coordinate,
+		); err != nil {
+			return err
+		}
+		directive := schema.Directive(appliedDirective.Name)
+		if err := invariantf(
+			directive != nil,
+			`Unknown directive "@%v" applied to %v.`, appliedDirective.Name, coordinate,
+		); err != nil {
+			return err
+		}
+		allowed := false
+		for _, directiveLocation := range directive.Locations {
+			if directiveLocation == location {
+				allowed = true
+				break
+			}
+		}
+		if err := invariantf(
+			allowed,
+			`Directive "@%v" may not be applied to %v at location %v.`, directive.Name, coordinate, location,
+		); err != nil {
+			return err
+		}
+		if err := invariantf(
+			directive.IsRepeatable || !seen[directive.Name],
+			`Directive "@%v" can only be applied once to %v.`, directive.Name, coordinate,
+		); err != nil {
+			return err
+		}
+		seen[directive.Name] = true
+
+		argDefs := map[string]*Argument{}
+		for _, argDef := range directive.Args {
+			argDefs[argDef.Name()] = argDef
+		}
+		argNames := make([]string, 0, len(appliedDirective.Args))
+		for argName := range appliedDirective.Args {
+			argNames = append(argNames, argName)
+		}
+		sort.Strings(argNames)
+		for _, argName := range argNames {
+			if err := invariantf(
+				argDefs[argName] != nil,
+				`Directive "@%v" applied to %v has unknown argument "%v".`, directive.Name, coordinate, argName,
+			); err != nil {
+				return err
+			}
+		}
+		for _, argDef := range directive.Args {
+			value, ok := appliedDirective.Args[argDef.Name()]
+			if !ok {
+				if err := invariantf(
+					!isRequiredInput(argDef.Type, argDef.DefaultValue),
+					`Directive "@%v" applied to %v requires argument "%v" of type %v.`,
+					directive.Name, coordinate, argDef.Name(), argDef.Type,
+				); err != nil {
+					return err
+				}
+				continue
+			}
+			isValid, messages := isValidInputValue(value, argDef.Type)
+			if err := invariantf(
+				isValid,
+				`Directive "@%v" applied to %v has invalid value for argument "%v": %v`,
+				directive.Name, coordinate, argDef.Name(), strings.Join(messages, "\n"),
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
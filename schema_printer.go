@@ -0,0 +1,338 @@
+package graphql
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/fraym/graphql-go/language/printer"
+)
+
+// PrintSchema prints the types and directives defined by the schema as SDL.
+// Introspection types, specified scalars and specified directives are omitted.
+func PrintSchema(schema Schema) string {
+	return printFilteredSchema(
+		schema,
+		func(directive *Directive) bool {
+			return !isSpecifiedDirective(directive)
+		},
+		func(ttype Type) bool {
+			return !isIntrospectionType(ttype) && !isSpecifiedScalarType(ttype)
+		},
+	)
+}
+
+// PrintIntrospectionSchema prints the introspection types and specified directives as SDL.
+func PrintIntrospectionSchema(schema Schema) string {
+	return printFilteredSchema(schema, isSpecifiedDirective, isIntrospectionType)
+}
+
+func isSpecifiedDirective(directive *Directive) bool {
+	return isSpecifiedDirectiveName(directive.Name)
+}
+
+func isIntrospectionType(ttype Type) bool {
+	return strings.HasPrefix(ttype.Name(), "__")
+}
+
+func isSpecifiedScalarType(ttype Type) bool {
+	_, ok := specifiedScalarTypes[ttype.Name()]
+	return ok
+}
+
+func printFilteredSchema(schema Schema, directiveFilter func(*Directive) bool, typeFilter func(Type) bool) string {
+	definitions := []string{}
+	if def := printSchemaDefinition(schema); def != "" {
+		definitions = append(definitions, def)
+	}
+	for _, directive := range schema.Directives() {
+		if directiveFilter(directive) {
+			definitions = append(definitions, printDirectiveDefinition(schema, directive))
+		}
+	}
+
+	typeMap := schema.TypeMap()
+	typeNames := make([]string, 0, len(typeMap))
+	for typeName, ttype := range typeMap {
+		if typeFilter(ttype) {
+			typeNames = append(typeNames, typeName)
+		}
+	}
+	sort.Strings(typeNames)
+	for _, typeName := range typeNames {
+		definitions = append(definitions, printTypeDefinition(schema, typeMap[typeName]))
+	}
+
+	return strings.Join(definitions, "\n\n")
+}
+
+// printSchemaDefinition prints the schema definition, which is only required
+// if the root operation types do not use the default names.
+func printSchemaDefinition(schema Schema) string {
+	queryType := schema.QueryType()
+	mutationType := schema.MutationType()
+	subscriptionType := schema.SubscriptionType()
+	if (queryType == nil || queryType.Name() == "Query") &&
+		(mutationType == nil || mutationType.Name() == "Mutation") &&
+		(subscriptionType == nil || subscriptionType.Name() == "Subscription") {
+		return ""
+	}
+
+	operationTypes := []string{}
+	if queryType != nil {
+		operationTypes = append(operationTypes, "  query: "+queryType.Name())
+	}
+	if mutationType != nil {
+		operationTypes = append(operationTypes, "  mutation: "+mutationType.Name())
+	}
+	if subscriptionType != nil {
+		operationTypes = append(operationTypes, "  subscription: "+subscriptionType.Name())
+	}
+	return "schema {\n" + strings.Join(operationTypes, "\n") + "\n}"
+}
+
+func printTypeDefinition(schema Schema, ttype Type) string {
+	switch ttype := ttype.(type) {
+	case *Scalar:
+		return printDescription(ttype.Description(), "", true) + "scalar " + ttype.Name() +
+			printSpecifiedByURL(ttype.SpecifiedByURL()) +
+			printAppliedDirectives(schema, ttype.AppliedDirectives())
+	case *Object:
+		return printDescription(ttype.PrivateDescription, "", true) +
+			"type " + ttype.Name() + printImplementedInterfaces(ttype.Interfaces()) +
+			printAppliedDirectives(schema, ttype.AppliedDirectives()) +
+			printFields(schema, ttype.Fields())
+	case *Interface:
+		return printDescription(ttype.Description(), "", true) +
+			"interface " + ttype.Name() + printImplementedInterfaces(ttype.Interfaces()) +
+			printAppliedDirectives(schema, ttype.AppliedDirectives()) +
+			printFields(schema, ttype.Fields())
+	case *Union:
+		members := []string{}
+		for _, member := range ttype.Types() {
+			members = append(members, member.Name())
+		}
+		str := printDescription(ttype.Description(), "", true) + "union " + ttype.Name() +
+			printAppliedDirectives(schema, ttype.AppliedDirectives())
+		if len(members) > 0 {
+			str += " = " + strings.Join(members, " | ")
+		}
+		return str
+	case *Enum:
+		values := append([]*EnumValueDefinition{}, ttype.Values()...)
+		sort.Slice(values, func(i, j int) bool {
+			return values[i].Name < values[j].Name
+		})
+		lines := []string{}
+		for i, value := range values {
+			lines = append(lines, printDescription(value.Description, "  ", i == 0)+
+				"  "+value.Name+printDeprecated(value.DeprecationReason)+
+				printAppliedDirectives(schema, value.AppliedDirectives))
+		}
+		return printDescription(ttype.Description(), "", true) +
+			"enum " + ttype.Name() + printAppliedDirectives(schema, ttype.AppliedDirectives()) +
+			printBlock(lines)
+	case *InputObject:
+		fields := ttype.Fields()
+		fieldNames := make([]string, 0, len(fields))
+		for fieldName := range fields {
+			fieldNames = append(fieldNames, fieldName)
+		}
+		sort.Strings(fieldNames)
+		lines := []string{}
+		for i, fieldName := range fieldNames {
+			field := fields[fieldName]
+			lines = append(lines, printDescription(field.Description(), "  ", i == 0)+
+				"  "+printInputValue(field.Name(), field.Type, field.DefaultValue)+
+				printDeprecated(field.DeprecationReason)+
+				printAppliedDirectives(schema, field.AppliedDirectives))
+		}
+		return printDescription(ttype.Description(), "", true) +
+			"input " + ttype.Name() + printOneOf(ttype.IsOneOf()) +
+			printAppliedDirectives(schema, ttype.AppliedDirectives()) + printBlock(lines)
+	}
+	return ""
+}
+
+func printImplementedInterfaces(interfaces []*Interface) string {
+	if len(interfaces) == 0 {
+		return ""
+	}
+	names := []string{}
+	for _, iface := range interfaces {
+		names = append(names, iface.Name())
+	}
+	return " implements " + strings.Join(names, " & ")
+}
+
+func printFields(schema Schema, fields FieldDefinitionMap) string {
+	fieldNames := make([]string, 0, len(fields))
+	for fieldName := range fields {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+	lines := []string{}
+	for i, fieldName := range fieldNames {
+		field := fields[fieldName]
+		lines = append(lines, printDescription(field.Description, "  ", i == 0)+
+			"  "+field.Name+printArgs(schema, field.Args, "  ")+": "+field.Type.String()+
+			printDeprecated(field.DeprecationReason)+
+			printAppliedDirectives(schema, field.AppliedDirectives))
+	}
+	return printBlock(lines)
+}
+
+func printBlock(lines []string) string {
+	if len(lines) == 0 {
+		return ""
+	}
+	return " {\n" + strings.Join(lines, "\n") + "\n}"
+}
+
+// printArgs prints arguments on a single line unless one of them has a description.
+func printArgs(schema Schema, args []*Argument, indentation string) string {
+	if len(args) == 0 {
+		return ""
+	}
+	hasDescription := false
+	for _, arg := range args {
+		if arg.Description() != "" {
+			hasDescription = true
+			break
+		}
+	}
+	if !hasDescription {
+		printed := []string{}
+		for _, arg := range args {
+			printed = append(printed, printInputValue(arg.Name(), arg.Type, arg.DefaultValue)+
+				printDeprecated(arg.DeprecationReason)+
+				printAppliedDirectives(schema, arg.AppliedDirectives))
+		}
+		return "(" + strings.Join(printed, ", ") + ")"
+	}
+
+	lines := []string{}
+	for i, arg := range args {
+		lines = append(lines, printDescription(arg.Description(), indentation+"  ", i == 0)+
+			indentation+"  "+printInputValue(arg.Name(), arg.Type, arg.DefaultValue)+
+			printDeprecated(arg.DeprecationReason)+
+			printAppliedDirectives(schema, arg.AppliedDirectives))
+	}
+	return "(\n" + strings.Join(lines, "\n") + "\n" + indentation + ")"
+}
+
+func printInputValue(name string, ttype Input, defaultValue any) string {
+	str := name + ": " + ttype.String()
+	if defaultValue != nil {
+		if valueAST := astFromValue(defaultValue, ttype); valueAST != nil {
+			str += fmt.Sprintf(" = %v", printer.Print(valueAST))
+		}
+	}
+	return str
+}
+
+func printDirectiveDefinition(schema Schema, directive *Directive) string {
+	return printDescription(directive.Description, "", true) +
+		"directive @" + directive.Name + printArgs(schema, directive.Args, "") +
+		printRepeatable(directive.IsRepeatable) +
+		" on " + strings.Join(directive.Locations, " | ")
+}
+
+func printRepeatable(isRepeatable bool) string {
+	if isRepeatable {
+		return " repeatable"
+	}
+	return ""
+}
+
+func printOneOf(isOneOf bool) string {
+	if isOneOf {
+		return " @oneOf"
+	}
+	return ""
+}
+
+func printSpecifiedByURL(url string) string {
+	if url == "" {
+		return ""
+	}
+	return fmt.Sprintf(" @specifiedBy(url: %v)", printer.Print(astFromValue(url, String)))
+}
+
+// printAppliedDirectives prints the applied directives with their arguments in
+// the order of the directive definition.
+func printAppliedDirectives(schema Schema, applied []*AppliedDirective) string {
+	str := ""
+	for _, appliedDirective := range applied {
+		str += " @" + appliedDirective.Name
+		directive := schema.Directive(appliedDirective.Name)
+		if directive == nil {
+			continue
+		}
+		args := []string{}
+		for _, arg := range directive.Args {
+			value, ok := appliedDirective.Args[arg.Name()]
+			if !ok {
+				continue
+			}
+			if valueAST := astFromValue(value, arg.Type); valueAST != nil {
+				args = append(args, fmt.Sprintf("%v: %v", arg.Name(), printer.Print(valueAST)))
+			} else {
+				args = append(args, arg.Name()+": null")
+			}
+		}
+		if len(args) > 0 {
+			str += "(" + strings.Join(args, ", ") + ")"
+		}
+	}
+	return str
+}
+
+func printDeprecated(reason string) string {
+	if reason == "" {
+		return ""
+	}
+	if reason == DefaultDeprecationReason {
+		return " @deprecated"
+	}
+	return fmt.Sprintf(" @deprecated(reason: %v)", printer.Print(astFromValue(reason, String)))
+}
+
+// printDescription prints the description as block string followed by a line break.
+// Descriptions of all but the first item of a block are preceded by an empty line.
+func printDescription(description, indentation string, firstInBlock bool) string {
+	if description == "" {
+		return ""
+	}
+	prefix := ""
+	if indentation != "" && !firstInBlock {
+		prefix = "\n"
+	}
+	lines := strings.Split(printBlockString(description), "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = indentation + line
+		}
+	}
+	return prefix + strings.Join(lines, "\n") + "\n"
+}
+
+// printBlockString prints the value as a block string which the lexer reads back unchanged.
+func printBlockString(value string) string {
+	escaped := strings.ReplaceAll(value, `"""`, `\"""`)
+	isSingleLine := !strings.Contains(value, "\n")
+	hasLeadingSpace := strings.HasPrefix(value, " ") || strings.HasPrefix(value, "\t")
+	hasTrailingQuote := strings.HasSuffix(value, `"`)
+	hasTrailingSlash := strings.HasSuffix(value, `\`)
+	printAsMultipleLines := !isSingleLine || hasTrailingQuote || hasTrailingSlash
+
+	str := `"""`
+	if printAsMultipleLines && !(isSingleLine && hasLeadingSpace) {
+		str += "\n"
+	}
+	str += escaped
+	if printAsMultipleLines {
+		str += "\n"
+	}
+	return str + `"""`
+}
@@ -0,0 +1,309 @@
+package graphql_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintSchema_PrintsSchemaBuiltFromConfig(t *testing.T) {
+	colorType := graphql.NewEnum(graphql.EnumConfig{
+		Name: "Color",
+		Values: graphql.EnumValueConfigMap{
+			"RED":   &graphql.EnumValueConfig{Value: 0},
+			"GREEN": &graphql.EnumValueConfig{Value: 1, Description: "Like grass"},
+			"BLUE":  &graphql.EnumValueConfig{Value: 2, DeprecationReason: "Use RED"},
+		},
+	})
+	filterType := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Filter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"color": &graphql.InputObjectFieldConfig{
+				Type:         colorType,
+				DefaultValue: 1,
+			},
+			"tags": &graphql.InputObjectFieldConfig{
+				Type:         graphql.NewList(graphql.NewNonNull(graphql.String)),
+				DefaultValue: []any{"a", "b"},
+			},
+		},
+	})
+	nodeType := graphql.NewInterface(graphql.InterfaceConfig{
+		Name: "Node",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+		},
+	})
+	thingType := graphql.NewObject(graphql.ObjectConfig{
+		Name:        "Thing",
+		Description: "A thing\nwith two lines",
+		Interfaces:  []*graphql.Interface{nodeType},
+		Fields: graphql.Fields{
+			"id": &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"name": &graphql.Field{
+				Type:              graphql.String,
+				DeprecationReason: graphql.DefaultDeprecationReason,
+			},
+		},
+	})
+	queryType := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Root",
+		Fields: graphql.Fields{
+			"things": &graphql.Field{
+				Type:        graphql.NewList(thingType),
+				Description: "All things",
+				Args: graphql.FieldConfigArgument{
+					{Name: "filter", Type: filterType, DefaultValue: map[string]any{"color": 2}},
+					{Name: "first", Type: graphql.Int, DefaultValue: int64(10)},
+				},
+			},
+			"node": &graphql.Field{
+				Type: nodeType,
+				Args: graphql.FieldConfigArgument{
+					{Name: "id", Type: graphql.NewNonNull(graphql.ID), Description: "The id"},
+				},
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: queryType,
+		Directives: append(graphql.SpecifiedDirectives, graphql.NewDirective(graphql.DirectiveConfig{
+			Name:        "cached",
+			Description: "Caches the field",
+			Locations:   []string{graphql.DirectiveLocationField, graphql.DirectiveLocationQuery},
+			Args: graphql.FieldConfigArgument{
+				{Name: "ttl", Type: graphql.Int, DefaultValue: 60},
+			},
+		})),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `schema {
+  query: Root
+}
+
+"""Caches the field"""
+directive @cached(ttl: Int = 60) on FIELD | QUERY
+
+enum Color {
+  BLUE @deprecated(reason: "Use RED")
+
+  """Like grass"""
+  GREEN
+  RED
+}
+
+input Filter {
+  color: Color = GREEN
+  tags: [String!] = ["a", "b"]
+}
+
+interface Node {
+  id: ID!
+}
+
+type Root {
+  node(
+    """The id"""
+    id: ID!
+  ): Node
+
+  """All things"""
+  things(filter: Filter = {color: BLUE}, first: Int = 10): [Thing]
+}
+
+"""
+A thing
+with two lines
+"""
+type Thing implements Node {
+  id: ID!
+  name: String @deprecated
+}`
+	if printed := graphql.PrintSchema(schema); printed != expected {
+		t.Fatalf("Unexpected SDL, Diff: %v", testutil.Diff(expected, printed))
+	}
+}
+
+func TestPrintSchema_RoundTripsThroughBuildSchema(t *testing.T) {
+	sdl := `schema {
+  query: Root
+  mutation: Change
+}
+
+"""Marks an experimental field"""
+directive @experimental(
+  """Why it is experimental"""
+  reason: String = "unstable"
+) on FIELD_DEFINITION | ENUM_VALUE
+
+type Change {
+  rename(input: RenameInput!): Named
+}
+
+enum Episode {
+  EMPIRE @deprecated(reason: "Prefer \"NEWHOPE\"")
+  NEWHOPE
+}
+
+interface Named {
+  """
+    Indented
+  line with \""" quotes
+  """
+  name: String
+}
+
+input RenameInput {
+  at: Timestamp
+  episodes: [Episode!] = [NEWHOPE]
+  name: String! = "none"
+}
+
+union Result = Robot | Ship
+
+type Robot implements Named {
+  name: String
+}
+
+type Root {
+  named(first: Int = 1, ratio: Float = 0.5, strict: Boolean = true): [Named!]!
+  search: Result
+}
+
+type Ship implements Named {
+  name: String
+  old: Boolean @deprecated
+}
+
+scalar Timestamp`
+	schema, err := graphql.BuildSchema(sdl, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	printed := graphql.PrintSchema(schema)
+	if printed != sdl {
+		t.Fatalf("Unexpected SDL, Diff: %v", testutil.Diff(sdl, printed))
+	}
+
+	rebuilt, err := graphql.BuildSchema(printed, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, printed, graphql.PrintSchema(rebuilt))
+}
+
+func TestPrintSchema_OmitsDefaultSchemaDefinition(t *testing.T) {
+	schema, err := graphql.BuildSchema(`type Query { a: Int }`, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, "type Query {\n  a: Int\n}", graphql.PrintSchema(schema))
+}
+
+func TestPrintIntrospectionSchema(t *testing.T) {
+	schema, err := graphql.BuildSchema(`type Query { a: Int }`, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	printed := graphql.PrintIntrospectionSchema(schema)
+
+	assert.NotContains(t, printed, "type Query")
+	assert.Contains(t, printed, "directive @include(\n  \"\"\"Included when true.\"\"\"\n  if: Boolean!\n) on FIELD | FRAGMENT_SPREAD | INLINE_FRAGMENT")
+	assert.Contains(t, printed, `directive @deprecated(`)
+	for _, typeName := range []string{"__Schema", "__Type", "__Field", "__InputValue", "__EnumValue", "__Directive"} {
+		assert.Contains(t, printed, "type "+typeName+" {")
+	}
+	assert.Contains(t, printed, "enum __TypeKind {")
+	assert.True(t, strings.Index(printed, "type __Directive {") < strings.Index(printed, "type __Schema {"))
+}
+
+func TestPrintSchema_PrintsInterfacesImplementingInterfaces(t *testing.T) {
+	sdl := `interface Named implements Node {
+  id: ID!
+  name: String
+}
+
+interface Node {
+  id: ID!
+}
+
+type Query {
+  node: Node
+}
+
+type User implements Named & Node {
+  id: ID!
+  name: String
+}`
+	schema, err := graphql.BuildSchema(sdl, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if printed := graphql.PrintSchema(schema); printed != sdl {
+		t.Fatalf("Unexpected SDL, Diff: %v", testutil.Diff(sdl, printed))
+	}
+}
+
+func TestPrintSchema_PrintsRepeatableDirectives(t *testing.T) {
+	sdl := `directive @tag(name: String!) repeatable on OBJECT | FIELD_DEFINITION
+
+type Query @tag(name: "a") @tag(name: "b") {
+  hello: String
+}`
+	schema, err := graphql.BuildSchema(sdl, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if printed := graphql.PrintSchema(schema); printed != sdl {
+		t.Fatalf("Unexpected SDL, Diff: %v", testutil.Diff(sdl, printed))
+	}
+}
+
+func TestPrintSchema_PrintsSpecifiedByURL(t *testing.T) {
+	sdl := `type Query {
+  id: UUID
+}
+
+scalar UUID @specifiedBy(url: "https://tools.ietf.org/html/rfc4122")`
+	schema, err := graphql.BuildSchema(sdl, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, "https://tools.ietf.org/html/rfc4122", schema.Type("UUID").(*graphql.Scalar).SpecifiedByURL())
+	if printed := graphql.PrintSchema(schema); printed != sdl {
+		t.Fatalf("Unexpected SDL, Diff: %v", testutil.Diff(sdl, printed))
+	}
+	assert.Contains(t, graphql.PrintIntrospectionSchema(schema), "directive @specifiedBy(")
+}
+
+func TestPrintSchema_PrintsDeprecatedArgumentsAndInputFields(t *testing.T) {
+	sdl := `directive @cache(ttl: Int, maxAge: Int @deprecated(reason: "Use ttl.")) on FIELD
+
+input PetFilter {
+  name: String
+  nick: String @deprecated
+}
+
+type Query {
+  pet(filter: PetFilter, id: ID @deprecated(reason: "Use filter.")): String
+}`
+	schema, err := graphql.BuildSchema(sdl, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if printed := graphql.PrintSchema(schema); printed != sdl {
+		t.Fatalf("Unexpected SDL, Diff: %v", testutil.Diff(sdl, printed))
+	}
+
+	clientSchema, err := graphql.BuildClientSchema(introspect(t, schema))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, sdl, graphql.PrintSchema(clientSchema))
+}
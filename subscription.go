@@ -57,6 +57,7 @@ func Subscribe(p Params) chan *Result {
 		OperationName: p.OperationName,
 		Args:          p.VariableValues,
 		Context:       p.Context,
+		OrderedData:   p.OrderedData,
 	})
 }
 
@@ -82,6 +83,7 @@ func ExecuteSubscription(p ExecuteParams) chan *Result {
 			OperationName: p.OperationName,
 			Args:          p.Args,
 			Context:       p.Context,
+			OrderedData:   p.OrderedData,
 		})
 	}
 	resultChannel := make(chan *Result)
@@ -130,15 +132,11 @@ func ExecuteSubscription(p ExecuteParams) chan *Result {
 			SelectionSet: exeContext.Operation.GetSelectionSet(),
 		})
 
-		responseNames := []string{}
-		for name := range fields {
-			responseNames = append(responseNames, name)
-		}
-		responseName := responseNames[0]
-		fieldNodes := fields[responseName]
+		responseName := fields.responseNames[0]
+		fieldNodes := fields.fieldASTs[responseName]
 		fieldNode := fieldNodes[0]
 		fieldName := fieldNode.Name.Value
-		fieldDef := getFieldDef(p.Schema, operationType, fieldName)
+		fieldDef := getFieldDef(p.Context, p.Schema, operationType, fieldName)
 
 		if fieldDef == nil {
 			resultChannel <- &Result{
@@ -162,16 +160,17 @@ func ExecuteSubscription(p ExecuteParams) chan *Result {
 
 		args := getArgumentValues(fieldDef.Args, fieldNode.Arguments, exeContext.VariableValues)
 		info := ResolveInfo{
-			FieldName:      fieldName,
-			FieldASTs:      fieldNodes,
-			Path:           fieldPath,
-			ReturnType:     fieldDef.Type,
-			ParentType:     operationType,
-			Schema:         p.Schema,
-			Fragments:      exeContext.Fragments,
-			RootValue:      exeContext.Root,
-			Operation:      exeContext.Operation,
-			VariableValues: exeContext.VariableValues,
+			FieldName:       fieldName,
+			FieldASTs:       fieldNodes,
+			FieldDefinition: fieldDef,
+			Path:            fieldPath,
+			ReturnType:      fieldDef.Type,
+			ParentType:      operationType,
+			Schema:          p.Schema,
+			Fragments:       exeContext.Fragments,
+			RootValue:       exeContext.Root,
+			Operation:       exeContext.Operation,
+			VariableValues:  exeContext.VariableValues,
 		}
 
 		fieldResult, err := resolveFn(ResolveParams{
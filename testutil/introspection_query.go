@@ -13,9 +13,10 @@ var IntrospectionQuery = `
         name
         description
 		locations
-        args {
+        args(includeDeprecated: true) {
           ...InputValue
         }
+        isRepeatable
         # deprecated, but included for coverage till removed
 		onOperation
         onFragment
@@ -28,10 +29,12 @@ var IntrospectionQuery = `
     kind
     name
     description
+    specifiedByURL
+    isOneOf
     fields(includeDeprecated: true) {
       name
       description
-      args {
+      args(includeDeprecated: true) {
         ...InputValue
       }
       type {
@@ -40,7 +43,7 @@ var IntrospectionQuery = `
       isDeprecated
       deprecationReason
     }
-    inputFields {
+    inputFields(includeDeprecated: true) {
       ...InputValue
     }
     interfaces {
@@ -62,6 +65,8 @@ var IntrospectionQuery = `
     description
     type { ...TypeRef }
     defaultValue
+    isDeprecated
+    deprecationReason
   }
 
   fragment TypeRef on __Type {
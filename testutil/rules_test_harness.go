@@ -305,6 +305,18 @@ func init() {
 			},
 		},
 	})
+	oneOfInputObject := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:    "OneOfInput",
+		IsOneOf: true,
+		Fields: graphql.InputObjectConfigFieldMap{
+			"stringField": &graphql.InputObjectFieldConfig{
+				Type: graphql.String,
+			},
+			"intField": &graphql.InputObjectFieldConfig{
+				Type: graphql.Int,
+			},
+		},
+	})
 	complicatedArgs := graphql.NewObject(graphql.ObjectConfig{
 		Name: "ComplicatedArgs",
 		// TODO List
@@ -392,6 +404,15 @@ func init() {
 					},
 				},
 			},
+			"oneOfArgField": &graphql.Field{
+				Type: graphql.String,
+				Args: graphql.FieldConfigArgument{
+					&graphql.ArgumentConfig{
+						Name: "oneOfArg",
+						Type: oneOfInputObject,
+					},
+				},
+			},
 			"multipleReqs": &graphql.Field{
 				Type: graphql.String,
 				Args: graphql.FieldConfigArgument{
@@ -560,6 +581,16 @@ func init() {
 				Name:      "onInputFieldDefinition",
 				Locations: []string{graphql.DirectiveLocationInputFieldDefinition},
 			}),
+			graphql.NewDirective(graphql.DirectiveConfig{
+				Name: "repeatable",
+				Locations: []string{
+					graphql.DirectiveLocationQuery,
+					graphql.DirectiveLocationField,
+					graphql.DirectiveLocationFragmentSpread,
+					graphql.DirectiveLocationInlineFragment,
+				},
+				IsRepeatable: true,
+			}),
 		},
 		Types: []graphql.Type{
 			catType,
@@ -0,0 +1,105 @@
+package graphql
+
+import (
+	"github.com/fraym/graphql-go/gqlerrors"
+	"github.com/fraym/graphql-go/language/ast"
+)
+
+// FieldTransformParams Params for FieldTransformFn()
+type FieldTransformParams struct {
+	// TypeName is the name of the object or interface type the field belongs to.
+	TypeName string
+
+	// Field is the config of the field in the transformed schema. It may be
+	// modified and returned, e.g. to wrap its Resolve function or change its type.
+	Field *Field
+
+	// Directive is the directive applied to the field which triggered the transform.
+	Directive *AppliedDirective
+
+	// Schema is the schema being transformed.
+	Schema Schema
+}
+
+// FieldTransformFn returns the field replacing the given field in the transformed
+// schema, or nil to remove the field.
+type FieldTransformFn func(p FieldTransformParams) *Field
+
+// TransformSchema returns a new Schema in which every field with applied directives
+// is rewritten by the transforms of those directives, in the order the directives
+// are applied. The given schema is left unchanged.
+//
+// Transforms maps directive names to their FieldTransformFn. Directives without an
+// entry use their Directive.TransformField, if any.
+//
+// Example:
+//
+//	schema, err := TransformSchema(schema, map[string]FieldTransformFn{
+//	  "uppercase": func(p FieldTransformParams) *Field {
+//	    resolve := p.Field.Resolve
+//	    if resolve == nil {
+//	      resolve = DefaultResolveFn
+//	    }
+//	    p.Field.Resolve = func(rp ResolveParams) (any, error) {
+//	      value, err := resolve(rp)
+//	      if s, ok := value.(string); ok {
+//	        return strings.ToUpper(s), err
+//	      }
+//	      return value, err
+//	    }
+//	    return p.Field
+//	  },
+//	})
+func TransformSchema(schema Schema, transforms map[string]FieldTransformFn) (Schema, error) {
+	if schema.QueryType() == nil {
+		return Schema{}, gqlerrors.NewFormattedError("Must provide valid Schema")
+	}
+	fieldTransforms := map[string]FieldTransformFn{}
+	for _, directive := range schema.Directives() {
+		if directive.TransformField != nil {
+			fieldTransforms[directive.Name] = directive.TransformField
+		}
+	}
+	for name, transform := range transforms {
+		if err := invariantf(
+			schema.Directive(name) != nil,
+			`Cannot transform fields with unknown directive "@%v".`, name,
+		); err != nil {
+			return Schema{}, err
+		}
+		fieldTransforms[name] = transform
+	}
+
+	b := newSchemaBuilder(&schema, BuildSchemaOptions{})
+	b.fieldTransforms = fieldTransforms
+	return b.build(&ast.Document{})
+}
+
+// transformField applies the transforms of the directives applied to the field.
+// Types the transformed field refers to are replaced by those of the transformed schema.
+func (b *schemaBuilder) transformField(typeName string, field *Field) *Field {
+	for _, appliedDirective := range field.AppliedDirectives {
+		transform, ok := b.fieldTransforms[appliedDirective.Name]
+		if !ok || transform == nil {
+			continue
+		}
+		field = transform(FieldTransformParams{
+			TypeName:  typeName,
+			Field:     field,
+			Directive: appliedDirective,
+			Schema:    *b.base,
+		})
+		if field == nil {
+			return nil
+		}
+		if field.Type != nil {
+			field.Type = b.replaceType(field.Type).(Output)
+		}
+		for _, arg := range field.Args {
+			if arg != nil && arg.Type != nil {
+				arg.Type = b.replaceType(arg.Type).(Input)
+			}
+		}
+	}
+	return field
+}
@@ -0,0 +1,127 @@
+package graphql_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+const transformSchemaTestSDL = `directive @uppercase on FIELD_DEFINITION
+
+directive @hidden on FIELD_DEFINITION
+
+directive @required on FIELD_DEFINITION
+
+type Query {
+  greeting: String @uppercase
+  secret: String @hidden
+  count: Int @required
+}`
+
+func uppercaseFieldTransform(p graphql.FieldTransformParams) *graphql.Field {
+	resolve := p.Field.Resolve
+	if resolve == nil {
+		resolve = graphql.DefaultResolveFn
+	}
+	p.Field.Resolve = func(rp graphql.ResolveParams) (any, error) {
+		value, err := resolve(rp)
+		if s, ok := value.(string); ok {
+			return strings.ToUpper(s), err
+		}
+		return value, err
+	}
+	return p.Field
+}
+
+func TestTransformSchema_RewritesFieldsWithAppliedDirectives(t *testing.T) {
+	schema, err := graphql.BuildSchema(transformSchemaTestSDL, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	transformed, err := graphql.TransformSchema(schema, map[string]graphql.FieldTransformFn{
+		"uppercase": uppercaseFieldTransform,
+		"hidden": func(p graphql.FieldTransformParams) *graphql.Field {
+			return nil
+		},
+		"required": func(p graphql.FieldTransformParams) *graphql.Field {
+			p.Field.Type = graphql.NewNonNull(p.Field.Type)
+			return p.Field
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `directive @uppercase on FIELD_DEFINITION
+
+directive @hidden on FIELD_DEFINITION
+
+directive @required on FIELD_DEFINITION
+
+type Query {
+  count: Int! @required
+  greeting: String @uppercase
+}`
+	if printed := graphql.PrintSchema(transformed); printed != expected {
+		t.Fatalf("Unexpected SDL, Diff: %v", testutil.Diff(expected, printed))
+	}
+	assert.Contains(t, schema.QueryType().Fields(), "secret")
+
+	result := graphql.Do(graphql.Params{
+		Schema:        transformed,
+		RequestString: `{ greeting count }`,
+		RootObject:    map[string]any{"greeting": "hello", "count": 1},
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{"greeting": "HELLO", "count": int64(1)}, result.Data)
+}
+
+func TestTransformSchema_UsesTransformsDeclaredWithTheDirective(t *testing.T) {
+	uppercase := graphql.NewDirective(graphql.DirectiveConfig{
+		Name:           "uppercase",
+		Locations:      []string{graphql.DirectiveLocationFieldDefinition},
+		TransformField: uppercaseFieldTransform,
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"greeting": &graphql.Field{
+					Type:              graphql.String,
+					AppliedDirectives: []*graphql.AppliedDirective{{Name: "uppercase"}},
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return "hello", nil
+					},
+				},
+			},
+		}),
+		Directives: append(append([]*graphql.Directive{}, graphql.SpecifiedDirectives...), uppercase),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	transformed, err := graphql.TransformSchema(schema, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := graphql.Do(graphql.Params{Schema: transformed, RequestString: `{ greeting }`})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{"greeting": "HELLO"}, result.Data)
+
+	result = graphql.Do(graphql.Params{Schema: schema, RequestString: `{ greeting }`})
+	assert.Equal(t, map[string]any{"greeting": "hello"}, result.Data)
+}
+
+func TestTransformSchema_RejectsTransformsOfUnknownDirectives(t *testing.T) {
+	schema, err := graphql.BuildSchema(transformSchemaTestSDL, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	_, err = graphql.TransformSchema(schema, map[string]graphql.FieldTransformFn{
+		"auth": uppercaseFieldTransform,
+	})
+	assert.EqualError(t, err, `Cannot transform fields with unknown directive "@auth".`)
+}
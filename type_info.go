@@ -1,6 +1,8 @@
 package graphql
 
 import (
+	"context"
+
 	"github.com/fraym/graphql-go/language/ast"
 	"github.com/fraym/graphql-go/language/kinds"
 )
@@ -15,6 +17,7 @@ type fieldDefFn func(schema *Schema, parentType Type, fieldAST *ast.Field) *Fiel
 
 type TypeInfo struct {
 	schema          *Schema
+	ctx             context.Context
 	typeStack       []Output
 	parentTypeStack []Composite
 	inputTypeStack  []Input
@@ -31,6 +34,10 @@ type TypeInfoConfig struct {
 	// to support non-spec-compliant codebases. You should never need to use it.
 	// It may disappear in the future.
 	FieldDefFn fieldDefFn
+
+	// Context is passed to the IsVisible functions of fields. Fields which are
+	// not visible are treated as undefined.
+	Context context.Context
 }
 
 func NewTypeInfo(opts *TypeInfoConfig) *TypeInfo {
@@ -38,8 +45,13 @@ func NewTypeInfo(opts *TypeInfoConfig) *TypeInfo {
 	if getFieldDef == nil {
 		getFieldDef = DefaultTypeInfoFieldDef
 	}
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
 	return &TypeInfo{
 		schema:      opts.Schema,
+		ctx:         ctx,
 		getFieldDef: getFieldDef,
 	}
 }
@@ -96,6 +108,9 @@ func (ti *TypeInfo) Enter(node ast.Node) {
 		var fieldDef *FieldDefinition
 		if parentType != nil {
 			fieldDef = ti.getFieldDef(schema, parentType.(Type), node)
+			if !isFieldVisible(ti.ctx, parentType, fieldDef) {
+				fieldDef = nil
+			}
 		}
 		ti.fieldDefStack = append(ti.fieldDefStack, fieldDef)
 		if fieldDef != nil {
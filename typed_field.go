@@ -0,0 +1,187 @@
+package graphql
+
+import (
+	"context"
+	"encoding"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// TypedResolveParams Params for TypedFieldResolveFn()
+type TypedResolveParams[Src any, Args any] struct {
+	// Source is the source value.
+	Source Src
+
+	// Args holds the arguments of the field decoded into the Args struct.
+	Args Args
+
+	// Info is a collection of information about the current execution state.
+	Info ResolveInfo
+
+	// Context argument is a context value that is provided to every resolve function within an execution.
+	// It is commonly
+	// used to represent an authenticated user, or request-specific caches.
+	Context context.Context
+}
+
+type TypedFieldResolveFn[Src any, Args any, Out any] func(p TypedResolveParams[Src, Args]) (Out, error)
+
+// TypedField is the config of a field created with NewField.
+type TypedField[Src any, Args any, Out any] struct {
+	Name              string
+	Type              Output
+	Args              FieldConfigArgument
+	Resolve           TypedFieldResolveFn[Src, Args, Out]
+	DeprecationReason string
+	Description       string
+	AppliedDirectives []*AppliedDirective
+	IsVisible         IsVisibleFn
+	Timeout           time.Duration
+}
+
+// NewField creates a field whose resolver receives a typed source and its
+// arguments decoded into the Args struct, e.g.
+//
+//	type UserArgs struct {
+//	  ID string `json:"id"`
+//	}
+//
+//	"user": graphql.NewField(graphql.TypedField[*Query, UserArgs, *User]{
+//	  Type: userType,
+//	  Args: graphql.FieldConfigArgument{
+//	    &graphql.ArgumentConfig{Name: "id", Type: graphql.NewNonNull(graphql.ID)},
+//	  },
+//	  Resolve: func(p graphql.TypedResolveParams[*Query, UserArgs]) (*User, error) {
+//	    return p.Source.User(p.Args.ID)
+//	  },
+//	}),
+//
+// Arguments are decoded into Args like ResolveParams.DecodeArgs does; use struct{}
+// for fields without arguments. NewSchema checks that Out is compatible with the
+// Type of the field.
+func NewField[Src any, Args any, Out any](config TypedField[Src, Args, Out]) *Field {
+	field := &Field{
+		Name:              config.Name,
+		Type:              config.Type,
+		Args:              config.Args,
+		DeprecationReason: config.DeprecationReason,
+		Description:       config.Description,
+		AppliedDirectives: config.AppliedDirectives,
+		IsVisible:         config.IsVisible,
+		Timeout:           config.Timeout,
+		goType:            reflect.TypeOf((*Out)(nil)).Elem(),
+	}
+	if config.Resolve == nil {
+		return field
+	}
+	resolve := config.Resolve
+	argTypes := map[string]Input{}
+	for _, arg := range config.Args {
+		if arg != nil {
+			argTypes[arg.Name] = arg.Type
+		}
+	}
+	field.Resolve = func(p ResolveParams) (any, error) {
+		var source Src
+		if err := decodeSource(p.Source, &source); err != nil {
+			return nil, err
+		}
+		var args Args
+		if err := decodeArgs(p.Args, argTypes, &args); err != nil {
+			return nil, err
+		}
+		return resolve(TypedResolveParams[Src, Args]{
+			Source:  source,
+			Args:    args,
+			Info:    p.Info,
+			Context: p.Context,
+		})
+	}
+	return field
+}
+
+// decodeSource assigns the source value to target, dereferencing pointers to
+// the source type.
+func decodeSource[Src any](value any, target *Src) error {
+	if value == nil {
+		return nil
+	}
+	if source, ok := value.(Src); ok {
+		*target = source
+		return nil
+	}
+	rv := reflect.ValueOf(value)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		if source, ok := rv.Elem().Interface().(Src); ok {
+			*target = source
+			return nil
+		}
+	}
+	return fmt.Errorf("expected source of type %v but got: %T", reflect.TypeOf(target).Elem(), value)
+}
+
+var (
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	stringerType      = reflect.TypeOf((*fmt.Stringer)(nil)).Elem()
+)
+
+// isThunkType reports whether the Go type is a thunk returning a value and an
+// error, like func() (*User, error).
+func isThunkType(goType reflect.Type) bool {
+	return goType.Kind() == reflect.Func && goType.NumIn() == 0 && goType.NumOut() == 2 && goType.Out(1) == errorType
+}
+
+// isGoTypeCompatible reports whether values of the Go type can be completed as
+// values of the GraphQL output type.
+func isGoTypeCompatible(goType reflect.Type, ttype Output) bool {
+	// thunks, like the ones returned by dataloader.Loader, complete to the
+	// values they return
+	if isThunkType(goType) {
+		return isGoTypeCompatible(goType.Out(0), ttype)
+	}
+	if nonNull, ok := ttype.(*NonNull); ok {
+		return isGoTypeCompatible(goType, nonNull.OfType)
+	}
+	for goType.Kind() == reflect.Ptr {
+		goType = goType.Elem()
+	}
+	if goType.Kind() == reflect.Interface {
+		return true
+	}
+
+	switch ttype := ttype.(type) {
+	case *List:
+		if goType.Kind() != reflect.Slice && goType.Kind() != reflect.Array {
+			return false
+		}
+		return isGoTypeCompatible(goType.Elem(), ttype.OfType)
+	case *Object, *Interface, *Union:
+		return goType.Kind() == reflect.Struct || (goType.Kind() == reflect.Map && goType.Key().Kind() == reflect.String)
+	case *Enum:
+		for _, value := range ttype.Values() {
+			if value.Value != nil && isConvertibleKind(reflect.TypeOf(value.Value).Kind(), goType.Kind()) {
+				return true
+			}
+		}
+		return false
+	case *Scalar:
+		switch ttype {
+		case Int:
+			return isIntegerKind(goType.Kind())
+		case Float:
+			return isNumericKind(goType.Kind())
+		case Boolean:
+			return goType.Kind() == reflect.Bool
+		case String:
+			// String serializes values with fmt, which calls String but not
+			// MarshalText
+			return goType.Kind() == reflect.String ||
+				goType.Implements(stringerType) || reflect.PointerTo(goType).Implements(stringerType)
+		case ID:
+			return goType.Kind() == reflect.String || isIntegerKind(goType.Kind())
+		}
+		return true
+	}
+	return true
+}
@@ -0,0 +1,204 @@
+package graphql_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/stretchr/testify/assert"
+)
+
+type typedFieldTestUser struct {
+	Name    string
+	Friends []string
+}
+
+type typedFieldTestFilter struct {
+	Prefix string `json:"prefix"`
+	Limit  *int
+}
+
+type typedFieldTestFriendsArgs struct {
+	Filter typedFieldTestFilter `json:"filter"`
+	Upper  bool
+}
+
+func typedFieldTestSchema(t *testing.T, friends *graphql.Field) graphql.Schema {
+	user := graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.Fields{
+			"name": graphql.NewField(graphql.TypedField[*typedFieldTestUser, struct{}, string]{
+				Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(p graphql.TypedResolveParams[*typedFieldTestUser, struct{}]) (string, error) {
+					return p.Source.Name, nil
+				},
+			}),
+			"friends": friends,
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"user": &graphql.Field{
+					Type: user,
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return &typedFieldTestUser{Name: "Ada", Friends: []string{"alan", "grace", "anna"}}, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return schema
+}
+
+func TestNewField_DecodesSourceAndArgs(t *testing.T) {
+	filter := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Filter",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"prefix": &graphql.InputObjectFieldConfig{Type: graphql.String},
+			"limit":  &graphql.InputObjectFieldConfig{Type: graphql.Int},
+		},
+	})
+	friends := graphql.NewField(graphql.TypedField[typedFieldTestUser, typedFieldTestFriendsArgs, []string]{
+		Type: graphql.NewList(graphql.String),
+		Args: graphql.FieldConfigArgument{
+			&graphql.ArgumentConfig{Name: "filter", Type: filter},
+			&graphql.ArgumentConfig{Name: "upper", Type: graphql.Boolean, DefaultValue: false},
+		},
+		Resolve: func(p graphql.TypedResolveParams[typedFieldTestUser, typedFieldTestFriendsArgs]) ([]string, error) {
+			friends := []string{}
+			for _, friend := range p.Source.Friends {
+				if !strings.HasPrefix(friend, p.Args.Filter.Prefix) {
+					continue
+				}
+				if p.Args.Filter.Limit != nil && len(friends) == *p.Args.Filter.Limit {
+					break
+				}
+				if p.Args.Upper {
+					friend = strings.ToUpper(friend)
+				}
+				friends = append(friends, friend)
+			}
+			return friends, nil
+		},
+	})
+
+	result := graphql.Do(graphql.Params{
+		Schema: typedFieldTestSchema(t, friends),
+		RequestString: `query ($limit: Int) {
+			user {
+				name
+				all: friends
+				some: friends(filter: { prefix: "a", limit: $limit }, upper: true)
+			}
+		}`,
+		VariableValues: map[string]any{"limit": 1},
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{
+		"user": map[string]any{
+			"name": "Ada",
+			"all":  []any{"alan", "grace", "anna"},
+			"some": []any{"ALAN"},
+		},
+	}, result.Data)
+}
+
+func TestNewField_RejectsUnexpectedSource(t *testing.T) {
+	friends := graphql.NewField(graphql.TypedField[map[string]any, struct{}, []string]{
+		Type: graphql.NewList(graphql.String),
+		Resolve: func(p graphql.TypedResolveParams[map[string]any, struct{}]) ([]string, error) {
+			return nil, nil
+		},
+	})
+	result := graphql.Do(graphql.Params{
+		Schema:        typedFieldTestSchema(t, friends),
+		RequestString: `{ user { friends } }`,
+	})
+	if assert.Len(t, result.Errors, 1) {
+		assert.Equal(t, "expected source of type map[string]interface {} but got: *graphql_test.typedFieldTestUser", result.Errors[0].Message)
+	}
+}
+
+func TestNewField_ChecksOutputTypeWhenBuildingSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		field   *graphql.Field
+		message string
+	}{
+		{
+			name:    "scalar",
+			field:   graphql.NewField(graphql.TypedField[any, struct{}, string]{Type: graphql.Int}),
+			message: `Query.field resolves values of Go type string which are not compatible with type Int.`,
+		},
+		{
+			name:    "list",
+			field:   graphql.NewField(graphql.TypedField[any, struct{}, *int]{Type: graphql.NewList(graphql.Int)}),
+			message: `Query.field resolves values of Go type *int which are not compatible with type [Int].`,
+		},
+		{
+			name:    "text marshaler",
+			field:   graphql.NewField(graphql.TypedField[any, struct{}, typedFieldTestText]{Type: graphql.String}),
+			message: `Query.field resolves values of Go type graphql_test.typedFieldTestText which are not compatible with type String.`,
+		},
+		{
+			name:    "list items",
+			field:   graphql.NewField(graphql.TypedField[any, struct{}, []bool]{Type: graphql.NewNonNull(graphql.NewList(graphql.Float))}),
+			message: `Query.field resolves values of Go type []bool which are not compatible with type [Float]!.`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := graphql.NewSchema(graphql.SchemaConfig{
+				Query: graphql.NewObject(graphql.ObjectConfig{
+					Name:   "Query",
+					Fields: graphql.Fields{"field": test.field},
+				}),
+			})
+			assert.EqualError(t, err, test.message)
+		})
+	}
+
+	_, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"ints":  graphql.NewField(graphql.TypedField[any, struct{}, []*int64]{Type: graphql.NewList(graphql.Int)}),
+				"float": graphql.NewField(graphql.TypedField[any, struct{}, int]{Type: graphql.Float}),
+				"any":   graphql.NewField(graphql.TypedField[any, struct{}, any]{Type: graphql.String}),
+			},
+		}),
+	})
+	assert.NoError(t, err)
+}
+
+// typedFieldTestText implements encoding.TextMarshaler only, which String
+// doesn't serialize with.
+type typedFieldTestText struct{ text string }
+
+func (t typedFieldTestText) MarshalText() ([]byte, error) {
+	return []byte(t.text), nil
+}
+
+type typedFieldTestThunk[T any] func() (T, error)
+
+func TestNewField_ResolvesThunks(t *testing.T) {
+	friends := graphql.NewField(graphql.TypedField[*typedFieldTestUser, struct{}, typedFieldTestThunk[[]string]]{
+		Type: graphql.NewList(graphql.String),
+		Resolve: func(p graphql.TypedResolveParams[*typedFieldTestUser, struct{}]) (typedFieldTestThunk[[]string], error) {
+			return func() ([]string, error) {
+				return p.Source.Friends[:1], nil
+			}, nil
+		},
+	})
+	result := graphql.Do(graphql.Params{
+		Schema:        typedFieldTestSchema(t, friends),
+		RequestString: `{ user { friends } }`,
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{"user": map[string]any{"friends": []any{"alan"}}}, result.Data)
+}
@@ -6,7 +6,9 @@ import (
 
 // type Schema any
 
-// Result has the response, errors and extensions from the resolved schema
+// Result has the response, errors and extensions from the resolved schema.
+// Data of executed operations is a map[string]any, or an *OrderedMap with the
+// fields in the order of the selection set with ExecuteParams.OrderedData.
 type Result struct {
 	Data       any                        `json:"data"`
 	Errors     []gqlerrors.FormattedError `json:"errors,omitempty"`
@@ -171,7 +171,7 @@ func TestUnionIntersectionTypes_CanIntrospectOnUnionAndIntersectionTypes(t *test
 						"name": "name",
 					},
 				},
-				"interfaces": nil,
+				"interfaces": []any{},
 				"possibleTypes": []any{
 					map[string]any{
 						"name": "Dog",
@@ -636,3 +636,55 @@ func TestUnionIntersectionTypes_ValueMayBeNilPointer(t *testing.T) {
 	})
 	assert.Equal(t, expected, result)
 }
+
+func TestUnionIntersectionTypes_ExecutesInterfacesImplementingInterfaces(t *testing.T) {
+	schema, err := graphql.BuildSchema(interfaceInheritanceSDL, graphql.BuildSchemaOptions{
+		Resolvers: map[string]graphql.FieldResolveFn{
+			"Query.node": func(p graphql.ResolveParams) (any, error) {
+				return map[string]any{"__typename": "Photo", "id": "1", "url": "/a.png", "width": 640}, nil
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	query := `{
+		Image: __type(name: "Image") {
+			interfaces { name }
+			possibleTypes { name }
+		}
+		node {
+			id
+			... on Resource {
+				url
+				... on Image { width }
+			}
+		}
+	}`
+	expected := &graphql.Result{
+		Data: map[string]any{
+			"Image": map[string]any{
+				"interfaces": []any{
+					map[string]any{"name": "Resource"},
+					map[string]any{"name": "Node"},
+				},
+				"possibleTypes": []any{
+					map[string]any{"name": "Photo"},
+				},
+			},
+			"node": map[string]any{
+				"id":    "1",
+				"url":   "/a.png",
+				"width": int64(640),
+			},
+		},
+	}
+	result := testutil.TestExecute(t, graphql.ExecuteParams{
+		Schema: schema,
+		AST:    testutil.TestParse(t, query),
+	})
+	if !reflect.DeepEqual(expected, result) {
+		t.Fatalf("Unexpected result, Diff: %v", testutil.Diff(expected, result))
+	}
+}
@@ -1,157 +1,514 @@
 package graphql
 
 import (
+	"context"
 	"encoding"
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
+	"time"
+	"unicode"
 )
 
 const TAG = "json"
 
-// can't take recursive slice type
-// e.g
+// BindFields binds the tagged fields of a struct to graphql fields, e.g.
 //
-//	type Person struct{
-//		Friends []Person
+//	type Person struct {
+//		Human
+//		Name    string   `json:"name" description:"The full name."`
+//		Email   *string  `json:"email"`
+//		Friends []Person `json:"friends"`
+//		Nick    string   `json:"nick" deprecationReason:"Use name."`
 //	}
 //
-// it will throw panic stack-overflow
+// Struct fields are bound when they have a json tag, the fields of embedded
+// structs without a tag are bound as fields of the struct itself. Pointers and
+// slices are nullable, other values are non-null. Nested structs are bound to
+// objects named after their Go type (see Binder), time.Time to DateTime and
+// other encoding.TextMarshaler implementations to String. Integers are bound to
+// Int, which fails to serialize values beyond 32 bits, see Binder.Int64. Fields
+// of other types, such as maps or interfaces, are bound to nullable Strings
+// formatted with fmt.
+//
+// Methods are bound as fields when the struct lists them, see MethodBinder.
+//
+// The objects of nested structs are bound by a Binder used for this call only,
+// bind the types of a schema with the same Binder to share them.
 func BindFields(obj any) Fields {
-	t := reflect.TypeOf(obj)
-	v := reflect.ValueOf(obj)
-	fields := make(map[string]*Field)
+	return (&Binder{}).BindFields(obj)
+}
+
+// BindObject binds a struct to an object named after its Go type, see
+// BindFields for how its fields are bound and Binder for how objects are shared.
+func BindObject(obj any) *Object {
+	return (&Binder{}).BindObject(obj)
+}
+
+// BindArg binds the fields of a struct with the given json tags to arguments,
+// see Binder.BindArg.
+func BindArg(obj any, tags ...string) FieldConfigArgument {
+	return (&Binder{}).BindArg(obj, tags...)
+}
+
+// MethodBinder is implemented by structs which bind methods as fields, e.g.
+//
+//	func (p Person) BindMethods() []string {
+//		return []string{"FullName", "IsAdmin"}
+//	}
+//
+// The listed methods must take no arguments, a context.Context or ResolveParams
+// and return a value, optionally followed by an error. The fields are named
+// after the methods with a lower case first letter, e.g. FullName is bound to
+// fullName.
+type MethodBinder interface {
+	BindMethods() []string
+}
+
+// Binder binds Go types to graphql types. Every Go type is bound to one object,
+// so recursive types like
+//
+//	type Person struct {
+//		Friends []Person `json:"friends"`
+//	}
+//
+// and structs used by more than one bound type share the same object. Bind the
+// types of a schema with the same Binder, so they don't bind the same struct to
+// objects of the same name. The zero value is ready to use.
+type Binder struct {
+	// TypeName returns the name of the object bound to the struct type. It
+	// defaults to the name of the Go type, which is qualified by the name of its
+	// package when another type of the Binder has the same name. Input objects
+	// are named after the object with an Input suffix.
+	TypeName func(t reflect.Type) string
 
-	if t.Kind() == reflect.Ptr {
+	// Int64 is the scalar bound to int64, uint, uint32 and uint64 values, whose
+	// range exceeds the 32-bit Int. It defaults to Int, which fails to
+	// serialize values beyond its range; set it to a wider scalar, like the
+	// Int64 scalar of the scalars package, for such values.
+	Int64 *Scalar
+
+	mu           sync.Mutex
+	objects      map[reflect.Type]*Object
+	inputObjects map[reflect.Type]*InputObject
+	names        map[string]bool
+}
+
+// BindFields binds the tagged fields of a struct to graphql fields, see the
+// BindFields function.
+func (b *Binder) BindFields(obj any) Fields {
+	return b.bindFields(bindType(obj))
+}
+
+// BindObject binds a struct to an object, see the BindObject function.
+func (b *Binder) BindObject(obj any) *Object {
+	return b.boundObject(bindType(obj), "")
+}
+
+// BindArg binds the fields of a struct with the given json tags to arguments.
+// Their types are bound like the types of the fields of BindFields, except that
+// arguments are nullable and nested structs are bound to input objects.
+func (b *Binder) BindArg(obj any, tags ...string) FieldConfigArgument {
+	var config FieldConfigArgument
+	t := bindType(obj)
+	walkBoundFields(t, nil, false, map[string]bool{}, func(tag string, field reflect.StructField, nullable bool) {
+		if !inArray(tags, tag) {
+			return
+		}
+		argType := b.bindInputType(field.Type, t.Name()+field.Name)
+		if nonNull, ok := argType.(*NonNull); ok {
+			argType = nonNull.OfType
+		}
+		config = append(config, &ArgumentConfig{
+			Name:        tag,
+			Type:        argType,
+			Description: field.Tag.Get("description"),
+		})
+	})
+	return config
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	contextType       = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType         = reflect.TypeOf((*error)(nil)).Elem()
+	resolveParamsType = reflect.TypeOf(ResolveParams{})
+)
+
+func bindType(obj any) reflect.Type {
+	t := reflect.TypeOf(obj)
+	for t.Kind() == reflect.Ptr {
 		t = t.Elem()
-		v = v.Elem()
 	}
+	return t
+}
+
+// boundObject returns the object bound to the struct type t, name is used for
+// anonymous structs.
+func (b *Binder) boundObject(t reflect.Type, name string) *Object {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if object, ok := b.objects[t]; ok {
+		return object
+	}
+	if b.objects == nil {
+		b.objects = map[reflect.Type]*Object{}
+	}
+	object := NewObject(ObjectConfig{
+		Name: b.boundTypeName(t, name, ""),
+		Fields: FieldsThunk(func() Fields {
+			return b.bindFields(t)
+		}),
+	})
+	b.objects[t] = object
+	return object
+}
+
+// boundInputObject returns the input object bound to the struct type t, name is
+// used for anonymous structs.
+func (b *Binder) boundInputObject(t reflect.Type, name string) *InputObject {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if inputObject, ok := b.inputObjects[t]; ok {
+		return inputObject
+	}
+	if b.inputObjects == nil {
+		b.inputObjects = map[reflect.Type]*InputObject{}
+	}
+	inputObject := NewInputObject(InputObjectConfig{
+		Name: b.boundTypeName(t, name, "Input"),
+		Fields: InputObjectConfigFieldMapThunk(func() InputObjectConfigFieldMap {
+			return b.bindInputFields(t)
+		}),
+	})
+	b.inputObjects[t] = inputObject
+	return inputObject
+}
+
+// boundTypeName returns an unused name for the type bound to the struct type t.
+// Names taken by other types are qualified by the package of t and, failing
+// that, numbered.
+func (b *Binder) boundTypeName(t reflect.Type, name string, suffix string) string {
+	if b.TypeName != nil {
+		if typeName := b.TypeName(t); typeName != "" {
+			return typeName + suffix
+		}
+	}
+	if t.Name() != "" {
+		name = t.Name()
+	}
+	candidates := []string{name + suffix}
+	if pkgPath := t.PkgPath(); pkgPath != "" {
+		candidates = append(candidates, pkgPath[strings.LastIndex(pkgPath, "/")+1:]+"_"+name+suffix)
+	}
+	for i := 2; ; i++ {
+		for _, candidate := range candidates {
+			candidate = graphqlName(candidate)
+			if !b.names[candidate] {
+				if b.names == nil {
+					b.names = map[string]bool{}
+				}
+				b.names[candidate] = true
+				return candidate
+			}
+		}
+		candidates = []string{fmt.Sprintf("%v%v_%d", name, suffix, i)}
+	}
+}
+
+// graphqlName replaces the characters of Go type names which are not allowed
+// in graphql names, e.g. the brackets of generic types.
+func graphqlName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+func (b *Binder) bindFields(t reflect.Type) Fields {
+	fields := Fields{}
+	walkBoundFields(t, nil, false, map[string]bool{}, func(tag string, field reflect.StructField, nullable bool) {
+		graphType := b.bindOutputType(field.Type, t.Name()+field.Name)
+		if nonNull, ok := graphType.(*NonNull); ok && nullable {
+			graphType = nonNull.OfType
+		}
+		fields[tag] = &Field{
+			Type:              graphType,
+			Description:       field.Tag.Get("description"),
+			DeprecationReason: field.Tag.Get("deprecationReason"),
+			Resolve:           bindFieldResolver(t, field.Index),
+		}
+	})
+	b.bindMethods(fields, t)
+	return fields
+}
+
+func (b *Binder) bindInputFields(t reflect.Type) InputObjectConfigFieldMap {
+	fields := InputObjectConfigFieldMap{}
+	walkBoundFields(t, nil, false, map[string]bool{}, func(tag string, field reflect.StructField, nullable bool) {
+		fieldType := b.bindInputType(field.Type, t.Name()+field.Name)
+		if nonNull, ok := fieldType.(*NonNull); ok && nullable {
+			fieldType = nonNull.OfType
+		}
+		fields[tag] = &InputObjectFieldConfig{
+			Type:        fieldType,
+			Description: field.Tag.Get("description"),
+		}
+	})
+	return fields
+}
 
-	for i := 0; i < t.NumField(); i++ {
-		field := t.Field(i)
+// walkBoundFields calls bind for the tagged fields of the struct type at index
+// of the bound type, with the index of the field relative to the bound type.
+// Fields of outer structs take precedence over the fields of embedded structs,
+// the fields of structs embedded by pointer are nullable.
+func walkBoundFields(structType reflect.Type, index []int, nullable bool, seen map[string]bool, bind func(tag string, field reflect.StructField, nullable bool)) {
+	var embedded []reflect.StructField
+	for i := 0; i < structType.NumField(); i++ {
+		field := structType.Field(i)
+		field.Index = append(append([]int{}, index...), i)
 
 		tag := extractTag(field.Tag)
 		if tag == "-" {
 			continue
 		}
+		if tag == "" {
+			if field.Anonymous {
+				embedded = append(embedded, field)
+			}
+			continue
+		}
+		if !field.IsExported() || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		bind(tag, field, nullable)
+	}
 
+	for _, field := range embedded {
 		fieldType := field.Type
-
 		if fieldType.Kind() == reflect.Ptr {
 			fieldType = fieldType.Elem()
 		}
+		if fieldType.Kind() != reflect.Struct || isBoundScalar(fieldType) {
+			continue
+		}
+		walkBoundFields(fieldType, field.Index, nullable || field.Type.Kind() == reflect.Ptr, seen, bind)
+	}
+}
 
-		var graphType Output
-		if fieldType.Kind() == reflect.Struct {
-			itf := v.Field(i).Interface()
-			if _, ok := itf.(encoding.TextMarshaler); ok {
-				fieldType = reflect.TypeOf("")
-				goto nonStruct
-			}
-
-			structFields := BindFields(itf)
-
-			if tag == "" {
-				fields = appendFields(fields, structFields)
-				continue
-			} else {
-				graphType = NewObject(ObjectConfig{
-					Name:   tag,
-					Fields: structFields,
-				})
-			}
+// bindMethods binds the methods listed by the BindMethods method of the struct
+// type t, see MethodBinder. It panics if a method does not exist or cannot be
+// bound.
+func (b *Binder) bindMethods(fields Fields, t reflect.Type) {
+	binder, ok := reflect.New(t).Interface().(MethodBinder)
+	if !ok {
+		return
+	}
+	ptrType := reflect.PointerTo(t)
+	for _, methodName := range binder.BindMethods() {
+		method, ok := ptrType.MethodByName(methodName)
+		if !ok || !isBindableMethod(method.Type) {
+			panic(fmt.Sprintf("graphql: method %v.%v cannot be bound as a field", t, methodName))
+		}
+		fields[bindMethodName(method.Name)] = &Field{
+			Type:    b.bindOutputType(method.Type.Out(0), t.Name()+method.Name),
+			Resolve: bindMethodResolver(t, method),
 		}
+	}
+}
 
-	nonStruct:
-		if tag == "" {
-			continue
+// isBindableMethod reports whether the method, including its receiver, takes no
+// arguments, a context.Context or ResolveParams and returns a value, optionally
+// followed by an error.
+func isBindableMethod(method reflect.Type) bool {
+	switch method.NumIn() {
+	case 1:
+	case 2:
+		if method.In(1) != contextType && method.In(1) != resolveParamsType {
+			return false
 		}
+	default:
+		return false
+	}
+	switch method.NumOut() {
+	case 1:
+		return true
+	case 2:
+		return method.Out(1) == errorType
+	}
+	return false
+}
 
-		if graphType == nil {
-			graphType = getGraphType(fieldType)
+// bindMethodName lower cases the leading upper case letters of the method name,
+// e.g. FullName becomes fullName and URLPath becomes urlPath.
+func bindMethodName(name string) string {
+	runes := []rune(name)
+	for i := range runes {
+		if !unicode.IsUpper(runes[i]) {
+			break
 		}
-		fields[tag] = &Field{
-			Type: graphType,
-			Resolve: func(p ResolveParams) (any, error) {
-				return extractValue(tag, p.Source), nil
-			},
+		if i > 0 && i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+			break
 		}
+		runes[i] = unicode.ToLower(runes[i])
 	}
-	return fields
+	return string(runes)
+}
+
+func isBoundScalar(t reflect.Type) bool {
+	return t == timeType || t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType)
+}
+
+// bindOutputType returns the graphql type of values of the Go type. name is
+// used for anonymous structs.
+func (b *Binder) bindOutputType(t reflect.Type, name string) Output {
+	return b.bindGraphType(t, name, false).(Output)
+}
+
+// bindInputType returns the graphql input type of values of the Go type. name
+// is used for anonymous structs.
+func (b *Binder) bindInputType(t reflect.Type, name string) Input {
+	return b.bindGraphType(t, name, true).(Input)
 }
 
-func getGraphType(tipe reflect.Type) Output {
-	kind := tipe.Kind()
-	switch kind {
-	case reflect.String:
-		return String
-	case reflect.Int, reflect.Int8, reflect.Int32, reflect.Int64:
-		return Int
-	case reflect.Float32, reflect.Float64:
-		return Float
-	case reflect.Bool:
-		return Boolean
-	case reflect.Slice:
-		return getGraphList(tipe)
-	}
-	return String
-}
-
-func getGraphList(tipe reflect.Type) *List {
-	if tipe.Kind() == reflect.Slice {
-		switch tipe.Elem().Kind() {
-		case reflect.Int, reflect.Int8, reflect.Int32, reflect.Int64:
-			return NewList(Int)
+// bindGraphType binds structs to input objects if input is set and to objects
+// otherwise.
+func (b *Binder) bindGraphType(t reflect.Type, name string, input bool) Type {
+	nullable := false
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+		nullable = true
+	}
+
+	var graphType Type
+	switch {
+	case t == timeType:
+		graphType = DateTime
+	case isBoundScalar(t):
+		graphType = String
+	default:
+		switch t.Kind() {
 		case reflect.Bool:
-			return NewList(Boolean)
+			graphType = Boolean
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+			graphType = Int
+		case reflect.Int64, reflect.Uint, reflect.Uint32, reflect.Uint64:
+			graphType = Int
+			if b.Int64 != nil {
+				graphType = b.Int64
+			}
 		case reflect.Float32, reflect.Float64:
-			return NewList(Float)
+			graphType = Float
 		case reflect.String:
-			return NewList(String)
+			graphType = String
+		case reflect.Slice, reflect.Array:
+			graphType = NewList(b.bindGraphType(t.Elem(), name, input))
+			nullable = nullable || t.Kind() == reflect.Slice
+		case reflect.Struct:
+			if input {
+				graphType = b.boundInputObject(t, name)
+			} else {
+				graphType = b.boundObject(t, name)
+			}
+		default:
+			// other values, like maps, are formatted as strings
+			graphType = String
+			nullable = true
 		}
 	}
-	// finally bind object
-	t := reflect.New(tipe.Elem())
-	name := strings.Replace(fmt.Sprint(tipe.Elem()), ".", "_", -1)
-	obj := NewObject(ObjectConfig{
-		Name:   name,
-		Fields: BindFields(t.Elem().Interface()),
-	})
-	return NewList(obj)
-}
 
-func appendFields(dest, origin Fields) Fields {
-	for key, value := range origin {
-		dest[key] = value
+	if nullable {
+		return graphType
 	}
-	return dest
+	return NewNonNull(graphType)
 }
 
-func extractValue(originTag string, obj any) any {
-	val := reflect.Indirect(reflect.ValueOf(obj))
+// boundSource returns the addressable struct of type t the source holds.
+func boundSource(source any, t reflect.Type) (reflect.Value, bool) {
+	value := reflect.ValueOf(source)
+	if !value.IsValid() {
+		return value, false
+	}
+	if value.Kind() == reflect.Ptr && value.Type().Elem() == t {
+		return value.Elem(), !value.IsNil()
+	}
+	if value.Type() != t {
+		return value, false
+	}
+	ptr := reflect.New(t)
+	ptr.Elem().Set(value)
+	return ptr.Elem(), true
+}
 
-	for j := 0; j < val.NumField(); j++ {
-		field := val.Type().Field(j)
-		found := originTag == extractTag(field.Tag)
-		if field.Type.Kind() == reflect.Struct {
-			itf := val.Field(j).Interface()
+// boundValue returns the value to complete for a struct field or method result.
+func boundValue(value reflect.Value) any {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+	}
+	if value.Type() == timeType || value.Type() == reflect.PointerTo(timeType) {
+		return value.Interface()
+	}
+	if value.Kind() != reflect.Ptr && value.CanAddr() {
+		value = value.Addr()
+	}
+	if marshaler, ok := value.Interface().(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		if err != nil {
+			return nil
+		}
+		return string(text)
+	}
+	return reflect.Indirect(value).Interface()
+}
 
-			if str, ok := itf.(encoding.TextMarshaler); ok && found {
-				byt, _ := str.MarshalText()
-				return string(byt)
-			}
+func bindFieldResolver(t reflect.Type, index []int) FieldResolveFn {
+	return func(p ResolveParams) (any, error) {
+		source, ok := boundSource(p.Source, t)
+		if !ok {
+			return DefaultResolveFn(p)
+		}
+		value, err := source.FieldByIndexErr(index)
+		if err != nil {
+			// an embedded struct pointer is nil
+			return nil, nil
+		}
+		return boundValue(value), nil
+	}
+}
 
-			res := extractValue(originTag, itf)
-			if res != nil {
-				return res
+func bindMethodResolver(t reflect.Type, method reflect.Method) FieldResolveFn {
+	return func(p ResolveParams) (any, error) {
+		source, ok := boundSource(p.Source, t)
+		if !ok {
+			return DefaultResolveFn(p)
+		}
+		var in []reflect.Value
+		if method.Type.NumIn() == 2 {
+			if method.Type.In(1) == contextType {
+				ctx := p.Context
+				if ctx == nil {
+					ctx = context.Background()
+				}
+				in = append(in, reflect.ValueOf(ctx))
+			} else {
+				in = append(in, reflect.ValueOf(p))
 			}
 		}
-
-		if found {
-			return reflect.Indirect(val.Field(j)).Interface()
+		out := source.Addr().Method(method.Index).Call(in)
+		if len(out) == 2 && !out[1].IsNil() {
+			return nil, out[1].Interface().(error)
 		}
+		return boundValue(out[0]), nil
 	}
-	return nil
 }
 
 func extractTag(tag reflect.StructTag) string {
@@ -162,24 +519,6 @@ func extractTag(tag reflect.StructTag) string {
 	return t
 }
 
-// lazy way of binding args
-func BindArg(obj any, tags ...string) FieldConfigArgument {
-	v := reflect.Indirect(reflect.ValueOf(obj))
-	var config FieldConfigArgument
-	for i := 0; i < v.NumField(); i++ {
-		field := v.Type().Field(i)
-
-		mytag := extractTag(field.Tag)
-		if inArray(tags, mytag) {
-			config = append(config, &ArgumentConfig{
-				Name: mytag,
-				Type: getGraphType(field.Type),
-			})
-		}
-	}
-	return config
-}
-
 func inArray(slice any, item any) bool {
 	s := reflect.ValueOf(slice)
 	if s.Kind() != reflect.Slice {
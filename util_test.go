@@ -1,14 +1,20 @@
 package graphql_test
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"log"
+	"net/netip"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/language/location"
 	"github.com/fraym/graphql-go/testutil"
+	"github.com/stretchr/testify/assert"
 )
 
 type Person struct {
@@ -180,3 +186,291 @@ func TestBindArg(t *testing.T) {
 		t.Fatalf("Unexpected result, expected address to be %s but got %s", expectedAddress, newFriend.Address)
 	}
 }
+
+type bindTestUser struct {
+	*bindTestAudit
+	ID       uint64          `json:"id" description:"The id of the user."`
+	Name     string          `json:"name"`
+	Nick     *string         `json:"nick" deprecationReason:"Use name."`
+	IP       netip.Addr      `json:"ip"`
+	Scores   [][]int8        `json:"scores"`
+	Friends  []*bindTestUser `json:"friends"`
+	Settings map[string]any  `json:"settings"`
+	password string
+}
+
+type bindTestAudit struct {
+	CreatedAt time.Time  `json:"createdAt"`
+	DeletedAt *time.Time `json:"deletedAt"`
+}
+
+func (u bindTestUser) DisplayName() string {
+	return strings.ToUpper(u.Name)
+}
+
+func (u *bindTestUser) IsAdmin(ctx context.Context) (bool, error) {
+	if u.password == "" {
+		return false, errors.New("no password")
+	}
+	return ctx.Value(bindTestAdminKey{}) == u.Name, nil
+}
+
+func (u bindTestUser) String() string {
+	return u.Name
+}
+
+func (u bindTestUser) Initials() string {
+	return u.Name[:1]
+}
+
+func (u bindTestUser) BindMethods() []string {
+	return []string{"DisplayName", "IsAdmin"}
+}
+
+type bindTestAdminKey struct{}
+
+func bindTestSchema(t *testing.T, user *bindTestUser) graphql.Schema {
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"user": &graphql.Field{
+					Type: graphql.BindObject(bindTestUser{}),
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return user, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return schema
+}
+
+func TestBindObject_BuildsTypesFromGoTypes(t *testing.T) {
+	expected := `
+type Query {
+  user: bindTestUser
+}
+
+type bindTestUser {
+  createdAt: DateTime
+  deletedAt: DateTime
+  displayName: String!
+  friends: [bindTestUser]
+
+  """The id of the user."""
+  id: Int!
+  ip: String!
+  isAdmin: Boolean!
+  name: String!
+  nick: String @deprecated(reason: "Use name.")
+  scores: [[Int!]]
+  settings: String
+}`
+	printed := graphql.PrintSchema(bindTestSchema(t, nil))
+	assert.Contains(t, printed, strings.TrimPrefix(expected, "\n"))
+}
+
+func TestBindObject_ResolvesFieldsAndMethods(t *testing.T) {
+	ada := &bindTestUser{
+		ID:      1,
+		Name:    "ada",
+		IP:      netip.MustParseAddr("127.0.0.1"),
+		Scores:  [][]int8{{1, 2}, {3}},
+		Friends: []*bindTestUser{{ID: 2, Name: "alan", password: "secret"}},
+		bindTestAudit: &bindTestAudit{
+			CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+	}
+	result := graphql.Do(graphql.Params{
+		Schema: bindTestSchema(t, ada),
+		RequestString: `{
+			user {
+				id name displayName ip scores createdAt deletedAt
+				friends { id name createdAt isAdmin }
+			}
+		}`,
+		Context: context.WithValue(context.Background(), bindTestAdminKey{}, "alan"),
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{
+		"user": map[string]any{
+			"id":          int64(1),
+			"name":        "ada",
+			"displayName": "ADA",
+			"ip":          "127.0.0.1",
+			"scores":      []any{[]any{int64(1), int64(2)}, []any{int64(3)}},
+			"createdAt":   "2024-01-02T03:04:05Z",
+			"deletedAt":   nil,
+			"friends": []any{
+				map[string]any{"id": int64(2), "name": "alan", "createdAt": nil, "isAdmin": true},
+			},
+		},
+	}, result.Data)
+
+	result = graphql.Do(graphql.Params{
+		Schema:        bindTestSchema(t, ada),
+		RequestString: `{ user { name isAdmin } }`,
+	})
+	if assert.Len(t, result.Errors, 1) {
+		assert.Equal(t, "no password", result.Errors[0].Message)
+	}
+	assert.Equal(t, map[string]any{"user": nil}, result.Data)
+}
+
+func TestBinder_NamesTypesUniquely(t *testing.T) {
+	type SourceLocation struct {
+		File string `json:"file"`
+	}
+	type span struct {
+		Start  location.SourceLocation `json:"start"`
+		Source SourceLocation          `json:"source"`
+	}
+
+	binder := &graphql.Binder{}
+	object := binder.BindObject(span{})
+	assert.Same(t, object, binder.BindObject(&span{}))
+	fields := object.Fields()
+	assert.Equal(t, "SourceLocation!", fields["start"].Type.String())
+	assert.Equal(t, "graphql_go_test_SourceLocation!", fields["source"].Type.String())
+
+	binder = &graphql.Binder{
+		TypeName: func(t reflect.Type) string {
+			return strings.ToUpper(t.Name())
+		},
+	}
+	assert.Equal(t, "SPAN", binder.BindObject(span{}).Name())
+	assert.NotSame(t, graphql.BindObject(span{}), graphql.BindObject(span{}))
+}
+
+func TestBinder_BindsWideIntegersToInt64(t *testing.T) {
+	type counter struct {
+		Small int32  `json:"small"`
+		Big   uint64 `json:"big"`
+		Total *int64 `json:"total"`
+	}
+	fields := graphql.BindObject(counter{}).Fields()
+	assert.Equal(t, "Int!", fields["small"].Type.String())
+	assert.Equal(t, "Int!", fields["big"].Type.String())
+	assert.Equal(t, "Int", fields["total"].Type.String())
+
+	int64Scalar := graphql.NewScalar(graphql.ScalarConfig{
+		Name: "Int64",
+		Serialize: func(value any) (any, error) {
+			return value, nil
+		},
+	})
+	object := (&graphql.Binder{Int64: int64Scalar}).BindObject(counter{})
+	fields = object.Fields()
+	assert.Equal(t, "Int!", fields["small"].Type.String())
+	assert.Equal(t, "Int64!", fields["big"].Type.String())
+	assert.Equal(t, "Int64", fields["total"].Type.String())
+
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"counter": &graphql.Field{
+					Type: object,
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return counter{Small: 1, Big: 1 << 40}, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	result := graphql.Do(graphql.Params{Schema: schema, RequestString: `{ counter { small big total } }`})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{
+		"counter": map[string]any{"small": int64(1), "big": uint64(1 << 40), "total": nil},
+	}, result.Data)
+}
+
+func TestBinder_PanicsForUnboundMethods(t *testing.T) {
+	object := graphql.BindObject(bindTestUnboundMethod{})
+	assert.PanicsWithValue(t, "graphql: method graphql_test.bindTestUnboundMethod.Rename cannot be bound as a field", func() {
+		object.Fields()
+	})
+}
+
+type bindTestUnboundMethod struct {
+	Name string `json:"name"`
+}
+
+func (m *bindTestUnboundMethod) Rename(name string) {
+	m.Name = name
+}
+
+func (m bindTestUnboundMethod) BindMethods() []string {
+	return []string{"Rename"}
+}
+
+type bindTestFilter struct {
+	Name  *string          `json:"name" description:"The name to match."`
+	Limit uint8            `json:"limit"`
+	And   []bindTestFilter `json:"and"`
+	Meta  map[string]any   `json:"meta"`
+}
+
+func TestBindArg_BindsInputObjects(t *testing.T) {
+	var args struct {
+		Filter bindTestFilter `json:"filter"`
+		Since  time.Time      `json:"since"`
+		Skip   uint           `json:"skip"`
+		Meta   map[string]any `json:"meta"`
+	}
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"search": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.BindArg(args, "filter", "since", "skip", "meta"),
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return nil, p.DecodeArgs(&args)
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := `
+type Query {
+  search(filter: bindTestFilterInput, since: DateTime, skip: Int, meta: String): String
+}
+
+input bindTestFilterInput {
+  and: [bindTestFilterInput!]
+  limit: Int!
+  meta: String
+
+  """The name to match."""
+  name: String
+}`
+	assert.Contains(t, graphql.PrintSchema(schema), strings.TrimPrefix(expected, "\n"))
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ search(filter: { limit: 2, and: [{ limit: 300 }] }, skip: 1) }`,
+	})
+	if assert.Len(t, result.Errors, 1) {
+		assert.Equal(t, `Cannot decode "filter.and.0.limit": 300 cannot be represented by uint8`, result.Errors[0].Message)
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ search(filter: { limit: 2, and: [{ limit: 3 }] }, skip: 1) }`,
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, uint8(3), args.Filter.And[0].Limit)
+	assert.Equal(t, uint(1), args.Skip)
+}
@@ -0,0 +1,354 @@
+package graphql
+
+import (
+	"sort"
+	"strings"
+)
+
+// ValidateSchema runs the checks of the "Type Validation" section of the
+// GraphQL specification against the schema and returns every problem found.
+// An empty result means the schema is valid.
+//
+// NewSchema only asserts what execution relies on and stops at the first
+// failure; ValidateSchema can be used to report all remaining problems, e.g.
+// reserved names, input objects with output field types or circular
+// references through non-null input fields.
+func ValidateSchema(schema Schema) []error {
+	context := &schemaValidationContext{
+		schema: &schema,
+		errors: []error{},
+	}
+	context.validateRootTypes()
+	context.validateDirectives()
+	context.validateTypes()
+	return context.errors
+}
+
+type schemaValidationContext struct {
+	schema *Schema
+	errors []error
+}
+
+func (c *schemaValidationContext) reportf(condition bool, format string, a ...any) {
+	if err := invariantf(condition, format, a...); err != nil {
+		c.errors = append(c.errors, err)
+	}
+}
+
+func (c *schemaValidationContext) validateRootTypes() {
+	c.reportf(c.schema.QueryType() != nil, "Query root type must be provided.")
+
+	rootTypes := map[string]string{}
+	for _, root := range []struct {
+		operation string
+		ttype     *Object
+	}{
+		{"query", c.schema.QueryType()},
+		{"mutation", c.schema.MutationType()},
+		{"subscription", c.schema.SubscriptionType()},
+	} {
+		if root.ttype == nil {
+			continue
+		}
+		operation, ok := rootTypes[root.ttype.Name()]
+		c.reportf(
+			!ok,
+			`All root types must be different, "%v" type is used as %v and %v root types.`,
+			root.ttype.Name(), operation, root.operation,
+		)
+		rootTypes[root.ttype.Name()] = root.operation
+	}
+}
+
+func (c *schemaValidationContext) validateName(name string) {
+	if strings.HasPrefix(name, "__") {
+		c.reportf(false, `Name "%v" must not begin with "__", which is reserved by GraphQL introspection.`, name)
+		return
+	}
+	if err := assertValidName(name); err != nil {
+		c.errors = append(c.errors, err)
+	}
+}
+
+func (c *schemaValidationContext) validateDirectives() {
+	for _, directive := range c.schema.Directives() {
+		if directive == nil {
+			c.reportf(false, "Expected directive but got: nil.")
+			continue
+		}
+		if directive.err != nil {
+			c.errors = append(c.errors, directive.err)
+			continue
+		}
+		c.validateName(directive.Name)
+		c.reportf(len(directive.Locations) > 0, "Directive @%v must include 1 or more locations.", directive.Name)
+		c.validateArgs("@"+directive.Name, directive.Args)
+	}
+}
+
+// validateArgs validates the arguments of the field or directive identified by coordinate.
+func (c *schemaValidationContext) validateArgs(coordinate string, args []*Argument) {
+	seen := map[string]bool{}
+	for _, arg := range args {
+		argName := arg.Name()
+		c.validateName(argName)
+		c.reportf(!seen[argName], "Argument %v(%v:) can only be defined once.", coordinate, argName)
+		seen[argName] = true
+		if !IsInputType(arg.Type) {
+			c.reportf(false, "The type of %v(%v:) must be Input Type but got: %v.", coordinate, argName, arg.Type)
+			continue
+		}
+		c.reportf(
+			!isRequiredInput(arg.Type, arg.DefaultValue) || arg.DeprecationReason == "",
+			"Required argument %v(%v:) cannot be deprecated.", coordinate, argName,
+		)
+	}
+}
+
+func (c *schemaValidationContext) validateTypes() {
+	typeMap := c.schema.TypeMap()
+	typeNames := make([]string, 0, len(typeMap))
+	for typeName := range typeMap {
+		typeNames = append(typeNames, typeName)
+	}
+	sort.Strings(typeNames)
+
+	inputObjectsVisited := map[string]bool{}
+	for _, typeName := range typeNames {
+		ttype := typeMap[typeName]
+		if isIntrospectionType(ttype) {
+			continue
+		}
+		if ttype.Error() != nil {
+			c.errors = append(c.errors, ttype.Error())
+			continue
+		}
+		c.validateName(typeName)
+
+		switch ttype := ttype.(type) {
+		case *Object:
+			c.validateFields(ttype, ttype.Fields())
+			c.validateInterfaces(ttype)
+		case *Interface:
+			c.validateFields(ttype, ttype.Fields())
+			c.validateInterfaces(ttype)
+		case *Union:
+			c.validateUnionMembers(ttype)
+		case *Enum:
+			c.validateEnumValues(ttype)
+		case *InputObject:
+			c.validateInputFields(ttype)
+			c.validateInputObjectCircularRefs(ttype, inputObjectsVisited, []string{}, map[string]int{})
+		}
+	}
+}
+
+func (c *schemaValidationContext) validateFields(ttype Type, fields FieldDefinitionMap) {
+	c.reportf(len(fields) > 0, "Type %v must define one or more fields.", ttype)
+
+	fieldNames := make([]string, 0, len(fields))
+	for fieldName := range fields {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+	for _, fieldName := range fieldNames {
+		field := fields[fieldName]
+		c.validateName(fieldName)
+		c.reportf(
+			IsOutputType(field.Type),
+			"The type of %v.%v must be Output Type but got: %v.", ttype, fieldName, field.Type,
+		)
+		c.validateArgs(ttype.Name()+"."+fieldName, field.Args)
+	}
+}
+
+func (c *schemaValidationContext) validateInterfaces(ttype implementingType) {
+	implemented := map[string]bool{}
+	for _, iface := range ttype.Interfaces() {
+		if iface == nil {
+			c.reportf(false, "Type %v must only implement Interface types, it cannot implement: nil.", ttype)
+			continue
+		}
+		if iface.Name() == ttype.Name() {
+			c.reportf(false, "Type %v cannot implement itself because it would create a circular reference.", ttype)
+			continue
+		}
+		if implemented[iface.Name()] {
+			c.reportf(false, "Type %v can only implement %v once.", ttype, iface)
+			continue
+		}
+		implemented[iface.Name()] = true
+
+		c.validateTypeImplementsAncestors(ttype, iface)
+		c.validateTypeImplementsInterface(ttype, iface)
+	}
+}
+
+func (c *schemaValidationContext) validateTypeImplementsAncestors(ttype implementingType, iface *Interface) {
+	for _, transitive := range iface.Interfaces() {
+		if containsInterface(ttype.Interfaces(), transitive.Name()) {
+			continue
+		}
+		if transitive.Name() == ttype.Name() {
+			c.reportf(false,
+				"Type %v cannot implement %v because it would create a circular reference.", ttype, iface)
+			continue
+		}
+		c.reportf(false,
+			"Type %v must implement %v because it is implemented by %v.", ttype, transitive, iface)
+	}
+}
+
+func (c *schemaValidationContext) validateTypeImplementsInterface(ttype implementingType, iface *Interface) {
+	typeFields := ttype.Fields()
+	ifaceFields := iface.Fields()
+
+	fieldNames := make([]string, 0, len(ifaceFields))
+	for fieldName := range ifaceFields {
+		fieldNames = append(fieldNames, fieldName)
+	}
+	sort.Strings(fieldNames)
+	for _, fieldName := range fieldNames {
+		ifaceField := ifaceFields[fieldName]
+		typeField, ok := typeFields[fieldName]
+		if !ok {
+			c.reportf(false,
+				"Interface field %v.%v expected but %v does not provide it.", iface, fieldName, ttype)
+			continue
+		}
+		c.reportf(
+			isTypeSubTypeOf(c.schema, typeField.Type, ifaceField.Type),
+			"Interface field %v.%v expects type %v but %v.%v is type %v.",
+			iface, fieldName, ifaceField.Type, ttype, fieldName, typeField.Type,
+		)
+
+		for _, ifaceArg := range ifaceField.Args {
+			argName := ifaceArg.Name()
+			typeArg := findArgument(typeField.Args, argName)
+			if typeArg == nil {
+				c.reportf(false,
+					"Interface field argument %v.%v(%v:) expected but %v.%v does not provide it.",
+					iface, fieldName, argName, ttype, fieldName)
+				continue
+			}
+			c.reportf(
+				isEqualType(ifaceArg.Type, typeArg.Type),
+				"Interface field argument %v.%v(%v:) expects type %v but %v.%v(%v:) is type %v.",
+				iface, fieldName, argName, ifaceArg.Type, ttype, fieldName, argName, typeArg.Type,
+			)
+		}
+		for _, typeArg := range typeField.Args {
+			argName := typeArg.Name()
+			if findArgument(ifaceField.Args, argName) != nil {
+				continue
+			}
+			c.reportf(
+				!isRequiredInput(typeArg.Type, typeArg.DefaultValue),
+				`Argument "%v.%v(%v:)" must not be required type "%v" if not provided by the Interface field "%v.%v".`,
+				ttype, fieldName, argName, typeArg.Type, iface, fieldName,
+			)
+		}
+	}
+}
+
+func (c *schemaValidationContext) validateUnionMembers(union *Union) {
+	members := union.Types()
+	if union.Error() != nil {
+		c.errors = append(c.errors, union.Error())
+		return
+	}
+	c.reportf(len(members) > 0, "Union type %v must define one or more member types.", union)
+
+	included := map[string]bool{}
+	for _, member := range members {
+		if included[member.Name()] {
+			c.reportf(false, "Union type %v can only include type %v once.", union, member)
+			continue
+		}
+		included[member.Name()] = true
+	}
+}
+
+func (c *schemaValidationContext) validateEnumValues(enum *Enum) {
+	values := enum.Values()
+	c.reportf(len(values) > 0, "Enum type %v must define one or more values.", enum)
+
+	valueNames := make([]string, 0, len(values))
+	for _, value := range values {
+		valueNames = append(valueNames, value.Name)
+	}
+	sort.Strings(valueNames)
+	for _, valueName := range valueNames {
+		c.validateName(valueName)
+		c.reportf(
+			valueName != "true" && valueName != "false" && valueName != "null",
+			"Enum type %v cannot include value: %v.", enum, valueName,
+		)
+	}
+}
+
+func (c *schemaValidationContext) validateInputFields(inputObject *InputObject) {
+	fields := inputObject.Fields()
+	if inputObject.Error() != nil {
+		c.errors = append(c.errors, inputObject.Error())
+		return
+	}
+	c.reportf(len(fields) > 0, "Input Object type %v must define one or more fields.", inputObject)
+
+	for _, fieldName := range sortedInputFieldNames(fields) {
+		field := fields[fieldName]
+		c.validateName(fieldName)
+		if !IsInputType(field.Type) {
+			c.reportf(false,
+				"The type of %v.%v must be Input Type but got: %v.", inputObject, fieldName, field.Type)
+			continue
+		}
+		c.reportf(
+			!isRequiredInput(field.Type, field.DefaultValue) || field.DeprecationReason == "",
+			"Required input field %v.%v cannot be deprecated.", inputObject, fieldName,
+		)
+		if inputObject.IsOneOf() {
+			_, isNonNull := field.Type.(*NonNull)
+			c.reportf(!isNonNull, "OneOf input field %v.%v must be nullable.", inputObject, fieldName)
+			c.reportf(field.DefaultValue == nil, "OneOf input field %v.%v cannot have a default value.", inputObject, fieldName)
+		}
+	}
+}
+
+// validateInputObjectCircularRefs reports input objects that cannot be provided
+// with a finite value because they reference themselves through non-null fields.
+// This does a depth first search over the non-null input fields, visiting every
+// input object once.
+func (c *schemaValidationContext) validateInputObjectCircularRefs(
+	inputObject *InputObject, visited map[string]bool, fieldPath []string, fieldPathIndexByTypeName map[string]int,
+) {
+	if visited[inputObject.Name()] {
+		return
+	}
+	visited[inputObject.Name()] = true
+	fieldPathIndexByTypeName[inputObject.Name()] = len(fieldPath)
+
+	fields := inputObject.Fields()
+	for _, fieldName := range sortedInputFieldNames(fields) {
+		nonNull, ok := fields[fieldName].Type.(*NonNull)
+		if !ok {
+			continue
+		}
+		fieldType, ok := nonNull.OfType.(*InputObject)
+		if !ok {
+			continue
+		}
+		cycleIndex, inPath := fieldPathIndexByTypeName[fieldType.Name()]
+		fieldPath = append(fieldPath, fieldName)
+		if !inPath {
+			c.validateInputObjectCircularRefs(fieldType, visited, fieldPath, fieldPathIndexByTypeName)
+		} else {
+			c.reportf(false,
+				`Cannot reference Input Object "%v" within itself through a series of non-null fields: "%v".`,
+				fieldType, strings.Join(fieldPath[cycleIndex:], "."))
+		}
+		fieldPath = fieldPath[:len(fieldPath)-1]
+	}
+
+	delete(fieldPathIndexByTypeName, inputObject.Name())
+}
@@ -0,0 +1,155 @@
+package graphql_test
+
+import (
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func validateSchemaErrorMessages(errs []error) []string {
+	messages := []string{}
+	for _, err := range errs {
+		messages = append(messages, err.Error())
+	}
+	return messages
+}
+
+func TestValidateSchema_AcceptsValidSchemas(t *testing.T) {
+	assert.Empty(t, graphql.ValidateSchema(testutil.StarWarsSchema))
+
+	schema, err := graphql.BuildSchema(`
+		directive @cache(ttl: Int) repeatable on FIELD_DEFINITION
+		interface Node { id: ID! }
+		interface Resource implements Node { id: ID! url: String }
+		type Image implements Resource & Node { id: ID! url(size: Int): String }
+		union Media = Image
+		enum Size { SMALL LARGE }
+		input Filter { size: Size next: Filter }
+		type Query { media(filter: Filter): [Media] @cache(ttl: 10) }
+	`, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Empty(t, graphql.ValidateSchema(schema))
+}
+
+func TestValidateSchema_ReportsEveryError(t *testing.T) {
+	object := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Object",
+		Fields: graphql.Fields{
+			"__field": &graphql.Field{Type: graphql.String},
+		},
+	})
+	self := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name:   "Self",
+		Fields: graphql.InputObjectConfigFieldMap{},
+	})
+	self.AddFieldConfig("self", &graphql.InputObjectFieldConfig{Type: graphql.NewNonNull(self)})
+	input := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Input",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"object": &graphql.InputObjectFieldConfig{Type: object},
+			"self":   &graphql.InputObjectFieldConfig{Type: self},
+		},
+	})
+	enum := graphql.NewEnum(graphql.EnumConfig{
+		Name: "Enum",
+		Values: graphql.EnumValueConfigMap{
+			"true": &graphql.EnumValueConfig{},
+		},
+	})
+	union := graphql.NewUnion(graphql.UnionConfig{
+		Name:  "Union",
+		Types: []*graphql.Object{object, object},
+		ResolveType: func(p graphql.ResolveTypeParams) *graphql.Object {
+			return object
+		},
+	})
+	query := graphql.NewObject(graphql.ObjectConfig{
+		Name: "Query",
+		Fields: graphql.Fields{
+			"field": &graphql.Field{
+				Type: union,
+				Args: graphql.FieldConfigArgument{
+					&graphql.ArgumentConfig{Name: "arg", Type: input},
+					&graphql.ArgumentConfig{Name: "arg", Type: enum},
+				},
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query:    query,
+		Mutation: query,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assert.Equal(t, []string{
+		`All root types must be different, "Query" type is used as query and mutation root types.`,
+		`Enum type Enum cannot include value: true.`,
+		`The type of Input.object must be Input Type but got: Object.`,
+		`Name "__field" must not begin with "__", which is reserved by GraphQL introspection.`,
+		`Argument Query.field(arg:) can only be defined once.`,
+		`Cannot reference Input Object "Self" within itself through a series of non-null fields: "self".`,
+		`Union type Union can only include type Object once.`,
+	}, validateSchemaErrorMessages(graphql.ValidateSchema(schema)))
+}
+
+func TestValidateSchema_ReportsCircularNonNullInputReferences(t *testing.T) {
+	schema, err := graphql.BuildSchema(`
+		input A { b: B! optional: A }
+		input B { c: C! list: [A!]! }
+		input C { a: A! }
+		type Query { field(a: A): String }
+	`, graphql.BuildSchemaOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	assert.Equal(t, []string{
+		`Cannot reference Input Object "A" within itself through a series of non-null fields: "b.c.a".`,
+	}, validateSchemaErrorMessages(graphql.ValidateSchema(schema)))
+}
+
+func TestValidateSchema_ReportsInvalidInterfaceImplementations(t *testing.T) {
+	node := graphql.NewInterface(graphql.InterfaceConfig{
+		Name: "Node",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+		},
+	})
+	named := graphql.NewInterface(graphql.InterfaceConfig{
+		Name:       "Named",
+		Interfaces: []*graphql.Interface{node},
+		Fields: graphql.Fields{
+			"id":   &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"name": &graphql.Field{Type: graphql.String},
+		},
+	})
+	user := graphql.NewObject(graphql.ObjectConfig{
+		Name:       "User",
+		Interfaces: []*graphql.Interface{named, named},
+		Fields: graphql.Fields{
+			"id":   &graphql.Field{Type: graphql.NewNonNull(graphql.ID)},
+			"name": &graphql.Field{Type: graphql.String},
+		},
+		IsTypeOf: func(p graphql.IsTypeOfParams) bool { return true },
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"user": &graphql.Field{Type: user},
+			},
+		}),
+	})
+	if err == nil {
+		t.Fatalf("expected NewSchema to reject the missing transitive interface")
+	}
+	assert.Equal(t, []string{
+		`Type User must implement Node because it is implemented by Named.`,
+		`Type User can only implement Named once.`,
+	}, validateSchemaErrorMessages(graphql.ValidateSchema(schema)))
+}
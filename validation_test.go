@@ -1692,3 +1692,226 @@ func TestTypeSystem_ObjectsMustAdhereToInterfaceTheyImplement_RejectsAnObjectWit
 		t.Fatalf("Expected error: %v, got %v", expectedError, err)
 	}
 }
+
+func TestTypeSystem_InterfacesMustAdhereToInterfaceTheyImplement_AcceptsAnInterfaceWhichImplementsAnInterface(t *testing.T) {
+	nodeInterface := graphql.NewInterface(graphql.InterfaceConfig{
+		Name: "Node",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+	})
+	resourceInterface := graphql.NewInterface(graphql.InterfaceConfig{
+		Name:       "Resource",
+		Interfaces: []*graphql.Interface{nodeInterface},
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"url": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	})
+	fileObject := graphql.NewObject(graphql.ObjectConfig{
+		Name:       "File",
+		Interfaces: []*graphql.Interface{resourceInterface, nodeInterface},
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+			"url": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"resource": &graphql.Field{
+					Type: resourceInterface,
+				},
+			},
+		}),
+		Types: []graphql.Type{fileObject},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if schema.Type("Node") != nodeInterface {
+		t.Fatalf("expected implemented interface Node to be part of the schema")
+	}
+	possibleTypes := schema.PossibleTypes(nodeInterface)
+	if len(possibleTypes) != 1 || possibleTypes[0] != fileObject {
+		t.Fatalf("unexpected possible types of Node: %v", possibleTypes)
+	}
+}
+
+func TestTypeSystem_InterfacesMustAdhereToInterfaceTheyImplement_RejectsAnInterfaceMissingAnInterfaceField(t *testing.T) {
+	nodeInterface := graphql.NewInterface(graphql.InterfaceConfig{
+		Name: "Node",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+	})
+	resourceInterface := graphql.NewInterface(graphql.InterfaceConfig{
+		Name:       "Resource",
+		Interfaces: []*graphql.Interface{nodeInterface},
+		Fields: graphql.Fields{
+			"url": &graphql.Field{
+				Type: graphql.String,
+			},
+		},
+	})
+	_, err := schemaWithFieldType(resourceInterface)
+	expectedError := `"Node" expects field "id" but "Resource" does not provide it.`
+	if err == nil || err.Error() != expectedError {
+		t.Fatalf("Expected error: %v, got %v", expectedError, err)
+	}
+}
+
+func TestTypeSystem_InterfacesMustAdhereToInterfaceTheyImplement_RejectsATypeMissingATransitiveInterface(t *testing.T) {
+	nodeInterface := graphql.NewInterface(graphql.InterfaceConfig{
+		Name: "Node",
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+	})
+	resourceInterface := graphql.NewInterface(graphql.InterfaceConfig{
+		Name:       "Resource",
+		Interfaces: []*graphql.Interface{nodeInterface},
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+	})
+	imageInterface := graphql.NewInterface(graphql.InterfaceConfig{
+		Name:       "Image",
+		Interfaces: []*graphql.Interface{resourceInterface},
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+	})
+	_, err := schemaWithFieldType(imageInterface)
+	expectedError := `Type Image must implement Node because it is implemented by Resource.`
+	if err == nil || err.Error() != expectedError {
+		t.Fatalf("Expected error: %v, got %v", expectedError, err)
+	}
+}
+
+func TestTypeSystem_InterfacesMustAdhereToInterfaceTheyImplement_RejectsAnInterfaceImplementingItself(t *testing.T) {
+	var nodeInterface *graphql.Interface
+	nodeInterface = graphql.NewInterface(graphql.InterfaceConfig{
+		Name: "Node",
+		Interfaces: graphql.InterfacesThunk(func() []*graphql.Interface {
+			return []*graphql.Interface{nodeInterface}
+		}),
+		Fields: graphql.Fields{
+			"id": &graphql.Field{
+				Type: graphql.NewNonNull(graphql.ID),
+			},
+		},
+	})
+	_, err := schemaWithFieldType(nodeInterface)
+	expectedError := `Type Node cannot implement itself because it would create a circular reference.`
+	if err == nil || err.Error() != expectedError {
+		t.Fatalf("Expected error: %v, got %v", expectedError, err)
+	}
+}
+
+func TestTypeSystem_DeprecatedInputValues_RejectsDeprecatedRequiredArgumentsAndInputFields(t *testing.T) {
+	_, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"field": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						&graphql.ArgumentConfig{
+							Name:              "arg",
+							Type:              graphql.NewNonNull(graphql.String),
+							DeprecationReason: "Removed",
+						},
+					},
+				},
+			},
+		}),
+	})
+	expected := "Required argument Query.field(arg:) cannot be deprecated."
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected error: %v, got %v", expected, err)
+	}
+
+	input := graphql.NewInputObject(graphql.InputObjectConfig{
+		Name: "Input",
+		Fields: graphql.InputObjectConfigFieldMap{
+			"field": &graphql.InputObjectFieldConfig{
+				Type:              graphql.NewNonNull(graphql.String),
+				DeprecationReason: "Removed",
+			},
+		},
+	})
+	_, err = schemaWithArgOfType(input)
+	expected = "Required input field Input.field cannot be deprecated."
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected error: %v, got %v", expected, err)
+	}
+
+	directive := graphql.NewDirective(graphql.DirectiveConfig{
+		Name:      "dir",
+		Locations: []string{graphql.DirectiveLocationField},
+		Args: graphql.FieldConfigArgument{
+			&graphql.ArgumentConfig{
+				Name:              "arg",
+				Type:              graphql.NewNonNull(graphql.String),
+				DeprecationReason: "Removed",
+			},
+		},
+	})
+	_, err = graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"field": &graphql.Field{Type: graphql.String},
+			},
+		}),
+		Directives: []*graphql.Directive{directive},
+	})
+	expected = "Required argument @dir(arg:) cannot be deprecated."
+	if err == nil || err.Error() != expected {
+		t.Fatalf("Expected error: %v, got %v", expected, err)
+	}
+}
+
+func TestTypeSystem_DeprecatedInputValues_AcceptsDeprecatedRequiredArgumentWithDefault(t *testing.T) {
+	_, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"field": &graphql.Field{
+					Type: graphql.String,
+					Args: graphql.FieldConfigArgument{
+						&graphql.ArgumentConfig{
+							Name:              "arg",
+							Type:              graphql.NewNonNull(graphql.String),
+							DefaultValue:      "default",
+							DeprecationReason: "Removed",
+						},
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
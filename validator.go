@@ -1,6 +1,8 @@
 package graphql
 
 import (
+	"context"
+
 	"github.com/fraym/graphql-go/gqlerrors"
 	"github.com/fraym/graphql-go/language/ast"
 	"github.com/fraym/graphql-go/language/kinds"
@@ -27,6 +29,13 @@ type ValidationResult struct {
  */
 
 func ValidateDocument(schema *Schema, astDoc *ast.Document, rules []ValidationRuleFn) (vr ValidationResult) {
+	return ValidateDocumentWithContext(context.Background(), schema, astDoc, rules)
+}
+
+// ValidateDocumentWithContext validates the document like ValidateDocument for the
+// request the context belongs to. Fields whose IsVisible function returns false for
+// the context are treated as undefined.
+func ValidateDocumentWithContext(ctx context.Context, schema *Schema, astDoc *ast.Document, rules []ValidationRuleFn) (vr ValidationResult) {
 	if len(rules) == 0 {
 		rules = SpecifiedRules
 	}
@@ -41,7 +50,8 @@ func ValidateDocument(schema *Schema, astDoc *ast.Document, rules []ValidationRu
 	}
 
 	typeInfo := NewTypeInfo(&TypeInfoConfig{
-		Schema: schema,
+		Schema:  schema,
+		Context: ctx,
 	})
 	vr.Errors = VisitUsingRules(schema, typeInfo, astDoc, rules)
 	if len(vr.Errors) == 0 {
@@ -124,6 +134,14 @@ func (ctx *ValidationContext) Schema() *Schema {
 	return ctx.schema
 }
 
+// Context returns the context of the request the document is validated for.
+func (ctx *ValidationContext) Context() context.Context {
+	if ctx.typeInfo == nil {
+		return context.Background()
+	}
+	return ctx.typeInfo.ctx
+}
+
 func (ctx *ValidationContext) Document() *ast.Document {
 	return ctx.astDoc
 }
@@ -230,7 +248,8 @@ func (ctx *ValidationContext) VariableUsages(node HasSelectionSet) []*VariableUs
 	}
 	usages := []*VariableUsage{}
 	typeInfo := NewTypeInfo(&TypeInfoConfig{
-		Schema: ctx.schema,
+		Schema:  ctx.schema,
+		Context: ctx.Context(),
 	})
 
 	visitor.Visit(node, visitor.VisitWithTypeInfo(typeInfo, &visitor.VisitorOptions{
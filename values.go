@@ -17,6 +17,9 @@ import (
 // Prepares an object map of variableValues of the correct type based on the
 // provided variable definitions and arbitrary input. If the input cannot be
 // parsed to match the variable definitions, a GraphQLError will be returned.
+//
+// Variables which were neither provided nor have a default value are left out,
+// while variables provided as null are kept with a nil value.
 func getVariableValues(
 	schema Schema,
 	definitionASTs []*ast.VariableDefinition,
@@ -28,9 +31,10 @@ func getVariableValues(
 			continue
 		}
 		varName := defAST.Variable.Name.Value
-		if varValue, err := getVariableValue(schema, defAST, inputs[varName]); err != nil {
+		input, provided := inputs[varName]
+		if varValue, err := getVariableValue(schema, defAST, input, provided); err != nil {
 			return values, err
-		} else {
+		} else if provided || defAST.DefaultValue != nil {
 			values[varName] = varValue
 		}
 	}
@@ -39,6 +43,10 @@ func getVariableValues(
 
 // Prepares an object map of argument values given a list of argument
 // definitions and list of argument AST nodes.
+//
+// Arguments which were neither provided nor have a default value are left out,
+// while arguments provided as null are kept with a nil value. An argument given
+// a variable which was not provided counts as not provided.
 func getArgumentValues(
 	argDefs []*Argument, argASTs []*ast.Argument,
 	variableValues map[string]any,
@@ -51,26 +59,33 @@ func getArgumentValues(
 	}
 	results := map[string]any{}
 	for _, argDef := range argDefs {
-		var (
-			tmp   any
-			value ast.Value
-		)
-		if tmpValue, ok := argASTMap[argDef.PrivateName]; ok {
-			value = tmpValue.Value
-		}
-		if tmp, _ = valueFromAST(value, argDef.Type, variableValues); isNullish(tmp) {
-			tmp = argDef.DefaultValue
+		argAST, provided := argASTMap[argDef.PrivateName]
+		if provided && !isMissingVariable(argAST.Value, variableValues) {
+			if value, err := valueFromAST(argAST.Value, argDef.Type, variableValues); err == nil {
+				results[argDef.PrivateName] = value
+				continue
+			}
 		}
-		if !isNullish(tmp) {
-			results[argDef.PrivateName] = tmp
+		if !isNullish(argDef.DefaultValue) {
+			results[argDef.PrivateName] = argDef.DefaultValue
 		}
 	}
 	return results
 }
 
+// isMissingVariable reports whether the value is a variable which was not provided.
+func isMissingVariable(valueAST ast.Value, variables map[string]any) bool {
+	variable, ok := valueAST.(*ast.Variable)
+	if !ok || variable.Name == nil {
+		return false
+	}
+	_, found := variables[variable.Name.Value]
+	return !found
+}
+
 // Given a variable definition, and any value of input, return a value which
 // adheres to the variable definition, or throw an error.
-func getVariableValue(schema Schema, definitionAST *ast.VariableDefinition, input any) (any, error) {
+func getVariableValue(schema Schema, definitionAST *ast.VariableDefinition, input any, provided bool) (any, error) {
 	ttype, err := typeFromAST(schema, definitionAST.Type)
 	if err != nil {
 		return nil, err
@@ -91,10 +106,8 @@ func getVariableValue(schema Schema, definitionAST *ast.VariableDefinition, inpu
 
 	isValid, messages := isValidInputValue(input, ttype)
 	if isValid {
-		if isNullish(input) {
-			if definitionAST.DefaultValue != nil {
-				return valueFromAST(definitionAST.DefaultValue, ttype, nil)
-			}
+		if !provided && definitionAST.DefaultValue != nil {
+			return valueFromAST(definitionAST.DefaultValue, ttype, nil)
 		}
 		return coerceValue(ttype, input)
 	}
@@ -179,6 +192,11 @@ func coerceValue(ttype Input, value any) (any, error) {
 				obj[name] = fieldValue
 			}
 		}
+		if ttype.IsOneOf() {
+			if err := assertOneOfValue(ttype, obj, len(valueMap)); err != nil {
+				return nil, err
+			}
+		}
 		return obj, nil
 	case *Scalar:
 		return ttype.ParseValue(value)
@@ -290,6 +308,13 @@ func isValidInputValue(value any, ttype Input) (bool, []string) {
 				messagesReduce = append(messagesReduce, fmt.Sprintf(`In field "%v": %v`, fieldName, message))
 			}
 		}
+
+		// Ensure exactly one field of a OneOf input object is given a non-null value.
+		if ttype.IsOneOf() {
+			if err := assertOneOfValue(ttype, valueMap, len(valueMap)); err != nil {
+				messagesReduce = append(messagesReduce, err.Error())
+			}
+		}
 		return (len(messagesReduce) == 0), messagesReduce
 	case *Scalar:
 		if _, err := ttype.ParseValue(value); err != nil {
@@ -395,6 +420,10 @@ func valueFromAST(valueAST ast.Value, ttype Input, variables map[string]any) (an
 		values := []any{}
 		if valueAST, ok := valueAST.(*ast.ListValue); ok {
 			for _, itemAST := range valueAST.Values {
+				if isMissingVariable(itemAST, variables) {
+					values = append(values, nil)
+					continue
+				}
 				recur, err := valueFromAST(itemAST, ttype.OfType, variables)
 				if err != nil {
 					return nil, err
@@ -424,25 +453,29 @@ func valueFromAST(valueAST ast.Value, ttype Input, variables map[string]any) (an
 			}
 			fieldASTs[of.Name.Value] = of
 		}
+		if ttype.IsOneOf() && len(fieldASTs) != 1 {
+			return nil, assertOneOfValue(ttype, nil, len(fieldASTs))
+		}
 		obj := map[string]any{}
 		for name, field := range ttype.Fields() {
-			var value any
-			if of, ok = fieldASTs[name]; ok {
+			if of, ok = fieldASTs[name]; ok && !isMissingVariable(of.Value, variables) {
 				recur, err := valueFromAST(of.Value, field.Type, variables)
 				if err != nil {
 					return nil, err
 				}
 				obj[name] = recur
-			} else {
-				value = field.DefaultValue
+			} else if !isNullish(field.DefaultValue) {
+				obj[name] = field.DefaultValue
 			}
-			if !isNullish(value) {
-				obj[name] = value
+		}
+		if ttype.IsOneOf() {
+			if err := assertOneOfValue(ttype, obj, len(fieldASTs)); err != nil {
+				return nil, err
 			}
 		}
 		return obj, nil
 	case *Scalar:
-		return ttype.ParseLiteral(valueAST)
+		return ttype.ParseLiteral(replaceVariables(valueAST, variables))
 	case *Enum:
 		return ttype.ParseLiteral(valueAST)
 	}
@@ -450,6 +483,58 @@ func valueFromAST(valueAST ast.Value, ttype Input, variables map[string]any) (an
 	return nil, fmt.Errorf("valueFromAST: unknown type %T", ttype)
 }
 
+// replaceVariables replaces the variables nested in a list or object literal,
+// e.g. the literal of a JSON scalar, by literals of their values, as
+// ParseLiteral cannot look up variables. Variables without a value are replaced
+// by null. Literals of the built-in scalars are never lists or objects, so
+// their coercion is not affected.
+func replaceVariables(valueAST ast.Value, variables map[string]any) ast.Value {
+	switch valueAST := valueAST.(type) {
+	case *ast.Variable:
+		if valueAST.Name == nil {
+			return valueAST
+		}
+		return astFromUntypedValue(variables[valueAST.Name.Value])
+	case *ast.ListValue:
+		values := make([]ast.Value, len(valueAST.Values))
+		for i, itemAST := range valueAST.Values {
+			values[i] = replaceVariables(itemAST, variables)
+		}
+		return ast.NewListValue(&ast.ListValue{
+			Loc:    valueAST.Loc,
+			Values: values,
+		})
+	case *ast.ObjectValue:
+		fields := make([]*ast.ObjectField, len(valueAST.Fields))
+		for i, field := range valueAST.Fields {
+			fields[i] = ast.NewObjectField(&ast.ObjectField{
+				Loc:   field.Loc,
+				Name:  field.Name,
+				Value: replaceVariables(field.Value, variables),
+			})
+		}
+		return ast.NewObjectValue(&ast.ObjectValue{
+			Loc:    valueAST.Loc,
+			Fields: fields,
+		})
+	}
+	return valueAST
+}
+
+// assertOneOfValue reports an error unless exactly one field of the OneOf input object
+// was given, as counted by the caller, and the value of that field is not null.
+func assertOneOfValue(ttype *InputObject, value map[string]any, fieldCount int) error {
+	if fieldCount != 1 {
+		return fmt.Errorf(`Exactly one key must be specified for OneOf type "%v".`, ttype.Name())
+	}
+	for name, fieldValue := range value {
+		if isNullish(fieldValue) {
+			return fmt.Errorf(`Field "%v.%v" must be non-null.`, ttype.Name(), name)
+		}
+	}
+	return nil
+}
+
 func invariant(condition bool, message string) error {
 	if !condition {
 		return gqlerrors.NewFormattedError(message)
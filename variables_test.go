@@ -1452,3 +1452,92 @@ func TestVariables_UsesArgumentDefaultValues_WhenArgumentProvidedCannotBeParsed(
 	}
 	assert.Equal(t, expected, result)
 }
+
+// literalValue converts a literal to a Go value, keeping the names of the
+// variables it contains.
+func literalValue(valueAST ast.Value) any {
+	switch valueAST := valueAST.(type) {
+	case *ast.ObjectValue:
+		fields := map[string]any{}
+		for _, field := range valueAST.Fields {
+			fields[field.Name.Value] = literalValue(field.Value)
+		}
+		return fields
+	case *ast.ListValue:
+		values := []any{}
+		for _, value := range valueAST.Values {
+			values = append(values, literalValue(value))
+		}
+		return values
+	case *ast.Variable:
+		return "$" + valueAST.Name.Value
+	}
+	return valueAST.GetValue()
+}
+
+func TestVariables_ReplacesVariablesNestedInScalarLiterals(t *testing.T) {
+	literalScalar := graphql.NewScalar(graphql.ScalarConfig{
+		Name: "Literal",
+		Serialize: func(value any) (any, error) {
+			return value, nil
+		},
+		ParseValue: func(value any) (any, error) {
+			return value, nil
+		},
+		ParseLiteral: func(valueAST ast.Value) (any, error) {
+			return literalValue(valueAST), nil
+		},
+	})
+	var args []any
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"literal": &graphql.Field{
+					Type: graphql.Boolean,
+					Args: graphql.FieldConfigArgument{
+						&graphql.ArgumentConfig{Name: "value", Type: literalScalar},
+					},
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						args = append(args, p.Args["value"])
+						return true, nil
+					},
+				},
+				"string": &graphql.Field{
+					Type: graphql.Boolean,
+					Args: graphql.FieldConfigArgument{
+						&graphql.ArgumentConfig{Name: "value", Type: graphql.String},
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	result := graphql.Do(graphql.Params{
+		Schema: schema,
+		RequestString: `query ($a: String, $b: Boolean, $c: Boolean, $d: Literal) {
+			nested: literal(value: { a: $a, b: [$b, 1, { c: $c }] })
+			variable: literal(value: $d)
+			string: literal(value: "$a")
+		}`,
+		VariableValues: map[string]any{"a": "x", "b": true, "d": "$d"},
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, []any{
+		map[string]any{"a": "x", "b": []any{true, "1", map[string]any{"c": nil}}},
+		"$d",
+		"$a",
+	}, args)
+
+	result = graphql.Do(graphql.Params{
+		Schema:         schema,
+		RequestString:  `query ($a: String) { string(value: [$a]) }`,
+		VariableValues: map[string]any{"a": "x"},
+	})
+	if assert.Len(t, result.Errors, 1) {
+		assert.Equal(t, "Argument \"value\" has invalid value [$a].\nExpected type \"String\", found [$a].\nError: cannot parse *ast.ListValue to string", result.Errors[0].Message)
+	}
+}
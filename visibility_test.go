@@ -0,0 +1,132 @@
+package graphql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/fraym/graphql-go"
+	"github.com/fraym/graphql-go/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+type visibilityTestRoleKey struct{}
+
+func visibilityTestContext(role string) context.Context {
+	return context.WithValue(context.Background(), visibilityTestRoleKey{}, role)
+}
+
+func visibilityTestAdminOnly(p graphql.IsVisibleParams) bool {
+	return p.Context.Value(visibilityTestRoleKey{}) == "admin"
+}
+
+func visibilityTestSchema(t *testing.T) graphql.Schema {
+	user := graphql.NewObject(graphql.ObjectConfig{
+		Name: "User",
+		Fields: graphql.Fields{
+			"name": &graphql.Field{Type: graphql.String},
+			"email": &graphql.Field{
+				Type:      graphql.String,
+				IsVisible: visibilityTestAdminOnly,
+			},
+		},
+	})
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name: "Query",
+			Fields: graphql.Fields{
+				"user": &graphql.Field{
+					Type: user,
+					Resolve: func(p graphql.ResolveParams) (any, error) {
+						return map[string]any{"name": "Ada", "email": "ada@example.com"}, nil
+					},
+				},
+			},
+		}),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	return schema
+}
+
+func TestFieldVisibility_HiddenFieldsAreRejectedByValidation(t *testing.T) {
+	schema := visibilityTestSchema(t)
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ user { email } }`,
+		Context:       visibilityTestContext("user"),
+	})
+	assert.Nil(t, result.Data)
+	if assert.Len(t, result.Errors, 1) {
+		assert.Equal(t, `Cannot query field "email" on type "User".`, result.Errors[0].Message)
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ user { emai } }`,
+	})
+	if assert.Len(t, result.Errors, 1) {
+		assert.Equal(t, `Cannot query field "emai" on type "User".`, result.Errors[0].Message)
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ user { emai } }`,
+		Context:       visibilityTestContext("admin"),
+	})
+	if assert.Len(t, result.Errors, 1) {
+		assert.Equal(t, `Cannot query field "emai" on type "User". Did you mean "email"?`, result.Errors[0].Message)
+	}
+
+	result = graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: `{ user { name email } }`,
+		Context:       visibilityTestContext("admin"),
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{
+		"user": map[string]any{"name": "Ada", "email": "ada@example.com"},
+	}, result.Data)
+}
+
+func TestFieldVisibility_HiddenFieldsAreNotExecuted(t *testing.T) {
+	result := graphql.Execute(graphql.ExecuteParams{
+		Schema:  visibilityTestSchema(t),
+		AST:     testutil.TestParse(t, `{ user { name email } }`),
+		Context: visibilityTestContext("user"),
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{
+		"user": map[string]any{"name": "Ada"},
+	}, result.Data)
+}
+
+func TestFieldVisibility_HiddenFieldsAreLeftOutOfIntrospection(t *testing.T) {
+	schema := visibilityTestSchema(t)
+	query := `{ __type(name: "User") { fields { name } } }`
+
+	result := graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+		Context:       visibilityTestContext("user"),
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{
+		"__type": map[string]any{
+			"fields": []any{map[string]any{"name": "name"}},
+		},
+	}, result.Data)
+
+	result = graphql.Do(graphql.Params{
+		Schema:        schema,
+		RequestString: query,
+		Context:       visibilityTestContext("admin"),
+	})
+	assert.Empty(t, result.Errors)
+	assert.Equal(t, map[string]any{
+		"__type": map[string]any{
+			"fields": []any{map[string]any{"name": "email"}, map[string]any{"name": "name"}},
+		},
+	}, result.Data)
+}